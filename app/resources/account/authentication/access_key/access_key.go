@@ -89,6 +89,7 @@ type AccessKeyRecord struct {
 	CreatedAt time.Time
 	Expires   opt.Optional[time.Time]
 	Disabled  bool
+	Scopes    Scopes
 }
 
 func (r *AccessKeyRecord) GetAuthenticationRecordIdentifier() string {
@@ -232,6 +233,7 @@ func AccessKeyRecordFromAuthenticationRecord(a authentication.Authentication) (*
 		CreatedAt: a.Created,
 		Expires:   a.Expires,
 		Disabled:  a.Disabled,
+		Scopes:    ScopesFromMetadata(a.Metadata),
 	}, nil
 }
 
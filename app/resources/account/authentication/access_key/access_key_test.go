@@ -294,3 +294,59 @@ func TestAccessKeyRecordFromAuthenticationRecord(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestScopes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unrestricted", func(t *testing.T) {
+		t.Parallel()
+		scopes, err := access_key.NewScopes(nil)
+		require.NoError(t, err)
+		assert.False(t, scopes.Restricted())
+		assert.True(t, scopes.AllowsTool("threads"))
+		assert.True(t, scopes.AllowsResources())
+	})
+
+	t.Run("tool_namespace", func(t *testing.T) {
+		t.Parallel()
+		scopes, err := access_key.NewScopes([]string{"mcp:tools:threads"})
+		require.NoError(t, err)
+		assert.True(t, scopes.Restricted())
+		assert.True(t, scopes.AllowsTool("threads"))
+		assert.False(t, scopes.AllowsTool("library"))
+		assert.False(t, scopes.AllowsResources())
+	})
+
+	t.Run("tool_wildcard", func(t *testing.T) {
+		t.Parallel()
+		scopes, err := access_key.NewScopes([]string{"mcp:tools:*", "mcp:resources:read"})
+		require.NoError(t, err)
+		assert.True(t, scopes.AllowsTool("threads"))
+		assert.True(t, scopes.AllowsTool("library"))
+		assert.True(t, scopes.AllowsResources())
+	})
+
+	t.Run("invalid_scope", func(t *testing.T) {
+		t.Parallel()
+		_, err := access_key.NewScopes([]string{"admin"})
+		assert.Error(t, err)
+
+		_, err = access_key.NewScopes([]string{"mcp:tools:"})
+		assert.Error(t, err)
+	})
+
+	t.Run("from_metadata", func(t *testing.T) {
+		t.Parallel()
+		pak := access_key.NewPersonalAccessKey(opt.NewEmpty[time.Time]())
+
+		auth := authentication.Authentication{
+			Identifier: pak.GetAuthenticationRecordIdentifier(),
+			Token:      string(pak.Hash),
+			Metadata:   map[string]any{"scopes": []any{"mcp:tools:links"}},
+		}
+
+		record, err := access_key.AccessKeyRecordFromAuthenticationRecord(auth)
+		require.NoError(t, err)
+		assert.Equal(t, access_key.Scopes{"mcp:tools:links"}, record.Scopes)
+	})
+}
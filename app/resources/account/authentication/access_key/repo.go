@@ -25,8 +25,9 @@ func New(db *ent.Client) *Repository {
 	return &Repository{db: db}
 }
 
-func (r *Repository) Create(ctx context.Context, accountID account.AccountID, kind AccessKeyKind, name string, expiry opt.Optional[time.Time]) (*AccessKeyRecordWithSecret, error) {
+func (r *Repository) Create(ctx context.Context, accountID account.AccountID, kind AccessKeyKind, name string, expiry opt.Optional[time.Time], scopes Scopes) (*AccessKeyRecordWithSecret, error) {
 	ak := newAccessKey(kind, expiry)
+	ak.Scopes = scopes
 
 	authRecord, err := r.create(ctx, accountID, ak, name)
 	if err != nil {
@@ -46,15 +47,20 @@ func (r *Repository) Create(ctx context.Context, accountID account.AccountID, ki
 }
 
 func (r *Repository) create(ctx context.Context, accountID account.AccountID, record AccessKeyRecordWithSecret, name string) (*authentication.Authentication, error) {
-	auth, err := r.db.Authentication.Create().
+	create := r.db.Authentication.Create().
 		SetService(authentication.ServiceAccessKey.String()).
 		SetTokenType(authentication.TokenTypePasswordHash.String()).
 		SetIdentifier(record.GetAuthenticationRecordIdentifier()).
 		SetToken(string(record.Hash)).
 		SetName(name).
 		SetNillableExpiresAt(record.Expires.Ptr()).
-		SetAccountAuthentication(xid.ID(accountID)).
-		Save(ctx)
+		SetAccountAuthentication(xid.ID(accountID))
+
+	if record.Scopes.Restricted() {
+		create.SetMetadata(record.Scopes.metadata())
+	}
+
+	auth, err := create.Save(ctx)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
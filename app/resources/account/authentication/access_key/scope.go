@@ -0,0 +1,114 @@
+package access_key
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+)
+
+var errInvalidScope = fault.New("invalid access key scope")
+
+const (
+	scopeMetadataKey  = "scopes"
+	scopeToolPrefix   = "mcp:tools:"
+	scopeToolWildcard = Scope(scopeToolPrefix + "*")
+)
+
+const ScopeResourcesRead = Scope("mcp:resources:read")
+
+// Scope restricts what an access key may be used for. Scopes only apply to the
+// MCP surface: "mcp:tools:<namespace>" grants calls to tools in a namespace and
+// "mcp:resources:read" grants reading resources. A key with no scopes is not
+// restricted at all, which is the behaviour of every key issued before scopes.
+type Scope string
+
+func NewScope(s string) (Scope, error) {
+	if s == string(ScopeResourcesRead) {
+		return ScopeResourcesRead, nil
+	}
+
+	if namespace, ok := strings.CutPrefix(s, scopeToolPrefix); ok && namespace != "" {
+		return Scope(s), nil
+	}
+
+	return "", fault.Wrap(errInvalidScope,
+		fmsg.WithDesc("unknown scope", "The scope '"+s+"' is not recognised, scopes must be either 'mcp:tools:<namespace>' or 'mcp:resources:read'."),
+		ftag.With(ftag.InvalidArgument))
+}
+
+func ToolScope(namespace string) Scope {
+	return Scope(scopeToolPrefix + namespace)
+}
+
+type Scopes []Scope
+
+func NewScopes(in []string) (Scopes, error) {
+	scopes := make(Scopes, 0, len(in))
+	for _, s := range in {
+		scope, err := NewScope(s)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes, nil
+}
+
+// Restricted reports whether the key is limited to a set of scopes at all.
+func (s Scopes) Restricted() bool {
+	return len(s) > 0
+}
+
+func (s Scopes) AllowsTool(namespace string) bool {
+	if !s.Restricted() {
+		return true
+	}
+	return slices.Contains(s, scopeToolWildcard) || slices.Contains(s, ToolScope(namespace))
+}
+
+func (s Scopes) AllowsResources() bool {
+	if !s.Restricted() {
+		return true
+	}
+	return slices.Contains(s, ScopeResourcesRead)
+}
+
+func (s Scopes) Strings() []string {
+	out := make([]string, len(s))
+	for i, v := range s {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func (s Scopes) metadata() map[string]any {
+	return map[string]any{scopeMetadataKey: s.Strings()}
+}
+
+// ScopesFromMetadata reads the scopes stored on an access key's authentication
+// record metadata. Records without scopes yield an empty, unrestricted list.
+func ScopesFromMetadata(metadata any) Scopes {
+	m, ok := metadata.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	raw, ok := m[scopeMetadataKey].([]any)
+	if !ok {
+		return nil
+	}
+
+	scopes := make(Scopes, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			scopes = append(scopes, Scope(s))
+		}
+	}
+
+	return scopes
+}
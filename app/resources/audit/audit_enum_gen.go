@@ -17,6 +17,7 @@ var (
 	EventTypeAccountSuspended     = EventType{eventTypeAccountSuspended}
 	EventTypeAccountUnsuspended   = EventType{eventTypeAccountUnsuspended}
 	EventTypeAccountContentPurged = EventType{eventTypeAccountContentPurged}
+	EventTypeAskerAbuseDetected   = EventType{eventTypeAskerAbuseDetected}
 )
 
 func (r EventType) Format(f fmt.State, verb rune) {
@@ -66,6 +67,8 @@ func NewEventType(__iNpUt__ string) (EventType, error) {
 		return EventTypeAccountUnsuspended, nil
 	case string(eventTypeAccountContentPurged):
 		return EventTypeAccountContentPurged, nil
+	case string(eventTypeAskerAbuseDetected):
+		return EventTypeAskerAbuseDetected, nil
 	default:
 		return EventType{}, fmt.Errorf("invalid value for type 'EventType': '%s'", __iNpUt__)
 	}
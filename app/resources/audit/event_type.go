@@ -10,4 +10,5 @@ const (
 	eventTypeAccountSuspended     eventTypeEnum = "account_suspended"
 	eventTypeAccountUnsuspended   eventTypeEnum = "account_unsuspended"
 	eventTypeAccountContentPurged eventTypeEnum = "account_content_purged"
+	eventTypeAskerAbuseDetected   eventTypeEnum = "asker_abuse_detected"
 )
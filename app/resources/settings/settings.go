@@ -52,6 +52,7 @@ type Settings struct {
 
 type ServiceSettings struct {
 	Moderation opt.Optional[ModerationServiceSettings]
+	MCP        opt.Optional[MCPServiceSettings]
 }
 
 type ModerationServiceSettings struct {
@@ -61,6 +62,12 @@ type ModerationServiceSettings struct {
 	WordReportList      opt.Optional[[]string]
 }
 
+type MCPServiceSettings struct {
+	// DisabledTools is a list of MCP tool names which are not exposed to MCP
+	// clients. Changing this notifies connected clients that the list changed.
+	DisabledTools opt.Optional[[]string]
+}
+
 // Merge will combine "updated" into "s" while overwriting any new values.
 func (s *Settings) Merge(updated Settings) error {
 	err := mergo.Merge(s, &updated, mergo.WithOverride)
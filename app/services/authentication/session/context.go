@@ -11,6 +11,7 @@ import (
 	"github.com/Southclaws/opt"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
 	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/resources/rbac"
 )
@@ -33,6 +34,9 @@ type sessionContext struct {
 	// sessionToken stores the session token for later revocation during logout.
 	// This is only populated for browser sessions, not access keys.
 	sessionToken opt.Optional[string]
+
+	// scopes is only populated for access keys which were issued with scopes.
+	scopes access_key.Scopes
 }
 
 func WithAccount(ctx context.Context, u account.Account, roles role.Roles) context.Context {
@@ -53,12 +57,13 @@ func WithAccountAndToken(ctx context.Context, u account.Account, roles role.Role
 	})
 }
 
-func WithAccessKey(ctx context.Context, u account.Account, roles role.Roles) context.Context {
+func WithAccessKey(ctx context.Context, u account.Account, roles role.Roles, scopes access_key.Scopes) context.Context {
 	return context.WithValue(ctx, contextKey, sessionContext{
 		account:        opt.New(u),
 		roles:          roles,
 		securityScheme: "access_key",
 		sessionToken:   opt.NewEmpty[string](),
+		scopes:         scopes,
 	})
 }
 
@@ -223,3 +228,19 @@ func GetSessionToken(ctx context.Context) opt.Optional[string] {
 
 	return sc.sessionToken
 }
+
+// GetAccessKeyScopes returns the scopes of the access key used for the call. A
+// browser session or an access key issued without scopes yields no scopes.
+func GetAccessKeyScopes(ctx context.Context) access_key.Scopes {
+	value := ctx.Value(contextKey)
+	if value == nil {
+		return nil
+	}
+
+	sc, ok := value.(sessionContext)
+	if !ok {
+		return nil
+	}
+
+	return sc.scopes
+}
@@ -116,7 +116,7 @@ func (v *Validator) ValidateAccessKeyToken(ctx context.Context, raw string) (con
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	return WithAccessKey(ctx, acc.Account, roles), nil
+	return WithAccessKey(ctx, acc.Account, roles, ark.Scopes), nil
 }
 
 // WithUnauthenticatedRoles returns a context with guest role permissions.
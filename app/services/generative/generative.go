@@ -3,6 +3,9 @@ package generative
 import (
 	"context"
 
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
 	"go.uber.org/fx"
 
 	"github.com/Southclaws/storyden/app/resources/datagraph"
@@ -22,6 +25,8 @@ type Summariser interface {
 	Summarise(ctx context.Context, content datagraph.Content) (string, error)
 }
 
+var errNoLanguageModel = fault.New("no language model available")
+
 var (
 	_ Titler     = &generator{}
 	_ Tagger     = &generator{}
@@ -36,6 +41,30 @@ func newGenerator(prompter ai.Prompter) *generator {
 	return &generator{prompter: prompter}
 }
 
+// prompterFor allows a request to provide its own prompter, such as an MCP
+// client's model via sampling, instead of the server's configured provider.
+func (g *generator) prompterFor(ctx context.Context) ai.Prompter {
+	return ai.PrompterFromContext(ctx, g.prompter)
+}
+
+// prompt fails when no language model produced a result, which is the case
+// when no provider is configured and the request did not provide a prompter.
+func (g *generator) prompt(ctx context.Context, input string) (*ai.Result, error) {
+	result, err := g.prompterFor(ctx).Prompt(ctx, input)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	if result == nil {
+		return nil, fault.Wrap(errNoLanguageModel,
+			fctx.With(ctx),
+			fmsg.WithDesc("no language model", "No language model is configured on this server to generate content."),
+		)
+	}
+
+	return result, nil
+}
+
 func Build() fx.Option {
 	return fx.Provide(
 		fx.Annotate(
@@ -38,7 +38,7 @@ func (g *generator) Summarise(ctx context.Context, content datagraph.Content) (s
 		return "", fault.Wrap(err, fctx.With(ctx))
 	}
 
-	result, err := g.prompter.Prompt(ctx, template.String())
+	result, err := g.prompt(ctx, template.String())
 	if err != nil {
 		return "", fault.Wrap(err, fctx.With(ctx))
 	}
@@ -29,7 +29,7 @@ func (g *generator) SuggestTitle(ctx context.Context, content datagraph.Content)
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	result, err := g.prompter.Prompt(ctx, template.String())
+	result, err := g.prompt(ctx, template.String())
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
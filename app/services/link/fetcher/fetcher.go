@@ -113,13 +113,41 @@ func (s *Fetcher) queueForItem(ctx context.Context, u url.URL, item datagraph.It
 	return nil
 }
 
-func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.LinkRef, *scrape.WebContent, error) {
+// ScrapeSteps is the number of steps ScrapeAndStore reports via WithProgress.
+const ScrapeSteps = 3
+
+// ProgressFunc receives each step of ScrapeAndStore as it starts, from 0 up to
+// but not including ScrapeSteps.
+type ProgressFunc func(step int, message string)
+
+type ScrapeOption func(*scrapeOptions)
+
+type scrapeOptions struct {
+	progress ProgressFunc
+}
+
+func WithProgress(fn ProgressFunc) ScrapeOption {
+	return func(o *scrapeOptions) {
+		o.progress = fn
+	}
+}
+
+func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL, opts ...ScrapeOption) (*link_ref.LinkRef, *scrape.WebContent, error) {
+	o := scrapeOptions{progress: func(int, string) {}}
+	for _, fn := range opts {
+		fn(&o)
+	}
+
+	o.progress(0, "Fetching "+u.String())
+
 	wc, err := s.sc.Scrape(ctx, u)
 	if err != nil {
 		s.logger.Warn("failed to scrape URL, storing link with basic information only",
 			slog.String("error", err.Error()),
 			slog.String("url", u.String()))
 
+		o.progress(2, "Saving link")
+
 		ln, err := s.lr.Store(ctx, u.String(), "", "", []link_writer.Option{}...)
 		if err != nil {
 			return nil, nil, fault.Wrap(err, fctx.With(ctx))
@@ -128,14 +156,16 @@ func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.Link
 		return ln, &scrape.WebContent{}, nil
 	}
 
-	opts := []link_writer.Option{}
+	o.progress(1, "Copying images")
+
+	lopts := []link_writer.Option{}
 
 	if wc.Favicon != "" {
 		a, err := s.CopyAsset(ctx, wc.Favicon)
 		if err != nil {
 			s.logger.Warn("failed to scrape web content favicon image", slog.String("error", err.Error()), slog.String("url", u.String()))
 		} else {
-			opts = append(opts, link_writer.WithFaviconImage(a.ID))
+			lopts = append(lopts, link_writer.WithFaviconImage(a.ID))
 		}
 	}
 
@@ -144,11 +174,13 @@ func (s *Fetcher) ScrapeAndStore(ctx context.Context, u url.URL) (*link_ref.Link
 		if err != nil {
 			s.logger.Warn("failed to scrape web content primary image", slog.String("error", err.Error()), slog.String("url", u.String()))
 		} else {
-			opts = append(opts, link_writer.WithPrimaryImage(a.ID))
+			lopts = append(lopts, link_writer.WithPrimaryImage(a.ID))
 		}
 	}
 
-	ln, err := s.lr.Store(ctx, u.String(), wc.Title, wc.Description, opts...)
+	o.progress(2, "Saving link")
+
+	ln, err := s.lr.Store(ctx, u.String(), wc.Title, wc.Description, lopts...)
 	if err != nil {
 		return nil, nil, fault.Wrap(err, fctx.With(ctx))
 	}
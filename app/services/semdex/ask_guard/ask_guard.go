@@ -0,0 +1,224 @@
+// Package ask_guard detects automated and abusive use of the Asker. Every
+// question is a language model request paid for by the instance operator so
+// the Asker is an attractive target for scripts farming free tokens.
+package ask_guard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/opt"
+	"github.com/mileusna/useragent"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/audit/audit_writer"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+)
+
+type Rule string
+
+const (
+	RuleRepeatedQuestion Rule = "repeated_question"
+	RuleQuestionVolume   Rule = "question_volume"
+	RuleAutomatedClient  Rule = "automated_client"
+)
+
+type Action string
+
+const (
+	ActionLog      Action = "log"
+	ActionThrottle Action = "throttle"
+	ActionBlock    Action = "block"
+)
+
+// Verdict is the outcome of a check. When Rule is empty, the question is fine.
+type Verdict struct {
+	Rule   Rule
+	Action Action
+}
+
+// Rejected reports whether the question must not be answered.
+func (v Verdict) Rejected() bool {
+	return v.Rule != "" && v.Action != ActionLog
+}
+
+// Client identifies who is asking, beyond the session.
+type Client struct {
+	Address   string
+	UserAgent string
+}
+
+// Names of HTTP libraries and crawlers which are not flagged as bots by the
+// user agent parser but have no business asking questions without a key.
+var automatedClients = []string{
+	"curl",
+	"wget",
+	"python-requests",
+	"python-urllib",
+	"aiohttp",
+	"httpx",
+	"go-http-client",
+	"node-fetch",
+	"axios",
+	"okhttp",
+	"scrapy",
+	"headlesschrome",
+	"phantomjs",
+}
+
+type auditWriter interface {
+	Create(ctx context.Context, eventType audit.EventType, enactedBy opt.Optional[account.AccountID], target opt.Optional[datagraph.Ref], metadata map[string]any) (*audit.AuditLog, error)
+}
+
+var _ auditWriter = &audit_writer.Writer{}
+
+type Guard struct {
+	logger *slog.Logger
+	store  cache.Store
+	writer auditWriter
+	action Action
+	window time.Duration
+	repeat opt.Optional[rate.Limiter]
+	volume opt.Optional[rate.Limiter]
+}
+
+func New(
+	cfg config.Config,
+	logger *slog.Logger,
+	store cache.Store,
+	f *rate.LimiterFactory,
+	writer *audit_writer.Writer,
+) *Guard {
+	limiter := func(limit int) opt.Optional[rate.Limiter] {
+		if limit <= 0 {
+			return opt.NewEmpty[rate.Limiter]()
+		}
+		return opt.New(f.NewLimiter(limit, cfg.AskerAbuseWindow, cfg.AskerAbuseWindow))
+	}
+
+	return &Guard{
+		logger: logger,
+		store:  store,
+		writer: writer,
+		action: Action(cfg.AskerAbuseAction),
+		window: cfg.AskerAbuseWindow,
+		repeat: limiter(cfg.AskerAbuseRepeatLimit),
+		volume: limiter(cfg.AskerAbuseVolumeLimit),
+	}
+}
+
+// Check counts the question against the abuse detection rules and returns the
+// first rule it triggered, if any, along with the configured action. Failures
+// of the underlying counters never prevent a question from being answered.
+func (g *Guard) Check(ctx context.Context, question string, client Client) Verdict {
+	accountID := session.GetOptAccountID(ctx)
+
+	who := client.Address
+	if id, ok := accountID.Get(); ok {
+		who = id.String()
+	}
+
+	rule, ok := g.evaluate(ctx, who, question, client)
+	if !ok {
+		return Verdict{}
+	}
+
+	verdict := Verdict{Rule: rule, Action: g.action}
+
+	g.report(ctx, who, accountID, client, verdict)
+
+	return verdict
+}
+
+func (g *Guard) evaluate(ctx context.Context, who string, question string, client Client) (Rule, bool) {
+	if scheme, err := session.GetSecurityScheme(ctx); err != nil || scheme != "access_key" {
+		if isAutomatedClient(client.UserAgent) {
+			return RuleAutomatedClient, true
+		}
+	}
+
+	if rl, ok := g.repeat.Get(); ok {
+		key := "ask_guard:repeat:" + who + ":" + hash(question)
+		if _, allowed, err := rl.Increment(ctx, key, 1); err == nil && !allowed {
+			return RuleRepeatedQuestion, true
+		}
+	}
+
+	if rl, ok := g.volume.Get(); ok {
+		key := "ask_guard:volume:" + who
+		if _, allowed, err := rl.Increment(ctx, key, 1); err == nil && !allowed {
+			return RuleQuestionVolume, true
+		}
+	}
+
+	return "", false
+}
+
+// report writes an audit log entry the first time a rule is triggered within
+// the window, subsequent triggers would only flood the audit log.
+func (g *Guard) report(ctx context.Context, who string, accountID opt.Optional[account.AccountID], client Client, v Verdict) {
+	// The marker is claimed atomically so that concurrent triggers, which are
+	// likely from a script firing many questions at once, report only once.
+	key := "ask_guard:reported:" + who + ":" + string(v.Rule)
+	claims, err := g.store.HIncrBy(ctx, key, "claims", 1)
+	if err != nil {
+		g.logger.Warn("failed to claim asker abuse report marker", slog.String("error", err.Error()))
+		return
+	}
+	if claims > 1 {
+		return
+	}
+
+	if err := g.store.Expire(ctx, key, g.window); err != nil {
+		g.logger.Warn("failed to expire asker abuse report marker", slog.String("error", err.Error()))
+	}
+
+	metadata := map[string]any{
+		"rule":   string(v.Rule),
+		"action": string(v.Action),
+	}
+	if !accountID.Ok() {
+		metadata["client_address"] = client.Address
+	}
+
+	if _, err := g.writer.Create(ctx, audit.EventTypeAskerAbuseDetected, accountID, opt.NewEmpty[datagraph.Ref](), metadata); err != nil {
+		g.logger.Error("failed to write asker abuse audit log",
+			slog.String("error", fault.Wrap(err, fctx.With(ctx)).Error()),
+		)
+	}
+}
+
+func isAutomatedClient(ua string) bool {
+	if strings.TrimSpace(ua) == "" {
+		return true
+	}
+
+	if useragent.Parse(ua).Bot {
+		return true
+	}
+
+	lower := strings.ToLower(ua)
+	for _, name := range automatedClients {
+		if strings.Contains(lower, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hash(s string) string {
+	h := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(s))))
+	return hex.EncodeToString(h[:])
+}
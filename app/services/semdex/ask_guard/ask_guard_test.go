@@ -0,0 +1,200 @@
+package ask_guard
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Southclaws/opt"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/audit"
+	"github.com/Southclaws/storyden/app/resources/datagraph"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache/local"
+	"github.com/Southclaws/storyden/internal/infrastructure/rate"
+)
+
+const browser = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"
+
+type recordingWriter struct {
+	events atomic.Int32
+}
+
+func (w *recordingWriter) Create(ctx context.Context, eventType audit.EventType, enactedBy opt.Optional[account.AccountID], target opt.Optional[datagraph.Ref], metadata map[string]any) (*audit.AuditLog, error) {
+	w.events.Add(1)
+	return &audit.AuditLog{}, nil
+}
+
+func newTestGuard(t *testing.T, action Action, repeat, volume int) (*Guard, *recordingWriter) {
+	store, err := local.New()
+	require.NoError(t, err)
+
+	g := New(config.Config{
+		AskerAbuseAction:      string(action),
+		AskerAbuseWindow:      time.Minute,
+		AskerAbuseRepeatLimit: repeat,
+		AskerAbuseVolumeLimit: volume,
+	}, slog.Default(), store, rate.NewFactory(store), nil)
+
+	w := &recordingWriter{}
+	g.writer = w
+
+	return g, w
+}
+
+func member() context.Context {
+	acc := account.Account{ID: account.AccountID(xid.New())}
+	return session.WithAccount(context.Background(), acc, role.Roles{})
+}
+
+// askUntil asks the question up to n times and returns the first verdict which
+// triggered a rule, along with how many questions were asked to trigger it.
+func askUntil(ctx context.Context, g *Guard, n int, question func(i int) string) (Verdict, int) {
+	for i := range n {
+		if v := g.Check(ctx, question(i), Client{Address: "192.0.2.1", UserAgent: browser}); v.Rule != "" {
+			return v, i + 1
+		}
+	}
+	return Verdict{}, n
+}
+
+func TestCheck(t *testing.T) {
+	t.Run("repeated_question", func(t *testing.T) {
+		a := assert.New(t)
+		g, w := newTestGuard(t, ActionThrottle, 3, 0)
+		ctx := member()
+
+		v, asked := askUntil(ctx, g, 5, func(int) string { return "What is Storyden?" })
+		a.Equal(RuleRepeatedQuestion, v.Rule)
+		a.Equal(ActionThrottle, v.Action)
+		a.True(v.Rejected())
+		a.GreaterOrEqual(asked, 3)
+
+		a.Empty(g.Check(ctx, "Something else entirely", Client{UserAgent: browser}).Rule)
+		a.Equal(int32(1), w.events.Load())
+	})
+
+	t.Run("question_volume", func(t *testing.T) {
+		a := assert.New(t)
+		g, w := newTestGuard(t, ActionBlock, 0, 3)
+		ctx := member()
+
+		v, asked := askUntil(ctx, g, 5, func(i int) string { return "Question " + string(rune('a'+i)) })
+		a.Equal(RuleQuestionVolume, v.Rule)
+		a.Equal(ActionBlock, v.Action)
+		a.True(v.Rejected())
+		a.GreaterOrEqual(asked, 3)
+
+		a.Equal(int32(1), w.events.Load())
+	})
+
+	t.Run("rules_are_per_account", func(t *testing.T) {
+		a := assert.New(t)
+		g, _ := newTestGuard(t, ActionThrottle, 0, 2)
+
+		v, _ := askUntil(member(), g, 4, func(i int) string { return "Question " + string(rune('a'+i)) })
+		a.Equal(RuleQuestionVolume, v.Rule)
+
+		a.Empty(g.Check(member(), "Question a", Client{UserAgent: browser}).Rule)
+	})
+
+	t.Run("spoofed_forwarded_for_shares_guest_counter", func(t *testing.T) {
+		a := assert.New(t)
+		g, _ := newTestGuard(t, ActionThrottle, 0, 3)
+		ctx := session.WithGuest(context.Background(), role.Roles{})
+
+		var v Verdict
+		for i := range 5 {
+			r := httptest.NewRequest(http.MethodGet, "/api/datagraph/ask", nil)
+			r.RemoteAddr = "192.0.2.1:51234"
+			r.Header.Set("X-Forwarded-For", fmt.Sprintf("203.0.113.%d", i))
+
+			address, err := limiter.ClientAddress(r)
+			require.NoError(t, err)
+			a.Equal("192.0.2.1", address)
+
+			if v = g.Check(ctx, fmt.Sprintf("Question %d", i), Client{Address: address, UserAgent: browser}); v.Rule != "" {
+				break
+			}
+		}
+
+		a.Equal(RuleQuestionVolume, v.Rule)
+	})
+
+	t.Run("automated_client", func(t *testing.T) {
+		a := assert.New(t)
+		g, _ := newTestGuard(t, ActionBlock, 0, 0)
+
+		v := g.Check(member(), "What is Storyden?", Client{UserAgent: "curl/8.5.0"})
+		a.Equal(RuleAutomatedClient, v.Rule)
+		a.True(v.Rejected())
+	})
+
+	t.Run("access_key_exempt_from_automated_client", func(t *testing.T) {
+		a := assert.New(t)
+		g, w := newTestGuard(t, ActionBlock, 0, 0)
+
+		acc := account.Account{ID: account.AccountID(xid.New())}
+		ctx := session.WithAccessKey(context.Background(), acc, role.Roles{}, nil)
+
+		v := g.Check(ctx, "What is Storyden?", Client{UserAgent: "curl/8.5.0"})
+		a.Empty(v.Rule)
+		a.False(v.Rejected())
+		a.Equal(int32(0), w.events.Load())
+	})
+
+	t.Run("concurrent_triggers_report_once", func(t *testing.T) {
+		a := assert.New(t)
+		g, w := newTestGuard(t, ActionLog, 0, 0)
+		ctx := member()
+
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				g.Check(ctx, "What is Storyden?", Client{UserAgent: "python-requests/2.31.0"})
+			}()
+		}
+		wg.Wait()
+
+		a.Equal(int32(1), w.events.Load())
+	})
+}
+
+func TestVerdictRejected(t *testing.T) {
+	a := assert.New(t)
+
+	a.False(Verdict{}.Rejected())
+	a.False(Verdict{Action: ActionBlock}.Rejected())
+	a.False(Verdict{Rule: RuleQuestionVolume, Action: ActionLog}.Rejected())
+	a.True(Verdict{Rule: RuleQuestionVolume, Action: ActionThrottle}.Rejected())
+	a.True(Verdict{Rule: RuleQuestionVolume, Action: ActionBlock}.Rejected())
+}
+
+func TestIsAutomatedClient(t *testing.T) {
+	a := assert.New(t)
+
+	a.False(isAutomatedClient("Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.4 Safari/605.1.15"))
+	a.False(isAutomatedClient("Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36"))
+
+	a.True(isAutomatedClient(""))
+	a.True(isAutomatedClient("curl/8.5.0"))
+	a.True(isAutomatedClient("python-requests/2.31.0"))
+	a.True(isAutomatedClient("Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) HeadlessChrome/124.0.0.0 Safari/537.36"))
+	a.True(isAutomatedClient("Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)"))
+	a.True(isAutomatedClient("Mozilla/5.0 AppleWebKit/537.36 (KHTML, like Gecko; compatible; GPTBot/1.2; +https://openai.com/gptbot)"))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/storyden/app/resources/datagraph/hydrate"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/ask_guard"
 	"github.com/Southclaws/storyden/app/services/semdex/asker"
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer/chromem_semdexer"
 	"github.com/Southclaws/storyden/app/services/semdex/semdexer/pinecone_semdexer"
@@ -52,6 +53,7 @@ func Build() fx.Option {
 	return fx.Options(
 		fx.Provide(
 			asker.New,
+			ask_guard.New,
 		),
 		fx.Provide(
 			fx.Annotate(
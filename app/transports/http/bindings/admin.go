@@ -85,16 +85,31 @@ func (a *Admin) AdminSettingsUpdate(ctx context.Context, request openapi.AdminSe
 	}
 
 	var services opt.Optional[settings.ServiceSettings]
-	if request.Body.Services != nil && request.Body.Services.Moderation != nil {
-		moderation := request.Body.Services.Moderation
-		services = opt.New(settings.ServiceSettings{
-			Moderation: opt.New(settings.ModerationServiceSettings{
+	if request.Body.Services != nil {
+		// Services are stored as a whole so any section which was not provided
+		// is carried over from the current settings rather than being cleared.
+		current, err := a.settingsManager.Get(ctx)
+		if err != nil {
+			return nil, fault.Wrap(err, fctx.With(ctx))
+		}
+		updated := current.Services.OrZero()
+
+		if moderation := request.Body.Services.Moderation; moderation != nil {
+			updated.Moderation = opt.New(settings.ModerationServiceSettings{
 				ThreadBodyLengthMax: opt.NewPtr(moderation.ThreadBodyLengthMax),
 				ReplyBodyLengthMax:  opt.NewPtr(moderation.ReplyBodyLengthMax),
 				WordBlockList:       opt.NewPtr(moderation.WordBlockList),
 				WordReportList:      opt.NewPtr(moderation.WordReportList),
-			}),
-		})
+			})
+		}
+
+		if mcp := request.Body.Services.Mcp; mcp != nil {
+			updated.MCP = opt.New(settings.MCPServiceSettings{
+				DisabledTools: opt.NewPtr(mcp.DisabledTools),
+			})
+		}
+
+		services = opt.New(updated)
 	}
 
 	settings, err := a.settingsManager.Set(ctx, settings.Settings{
@@ -371,6 +386,13 @@ func serialiseSettings(in *settings.Settings) openapi.AdminSettingsProps {
 func serialiseServiceSettings(in settings.ServiceSettings) openapi.AdminSettingsServiceProps {
 	return openapi.AdminSettingsServiceProps{
 		Moderation: opt.Map(in.Moderation, serialiseModerationSettings).Ptr(),
+		Mcp:        opt.Map(in.MCP, serialiseMCPSettings).Ptr(),
+	}
+}
+
+func serialiseMCPSettings(in settings.MCPServiceSettings) openapi.MCPServiceSettings {
+	return openapi.MCPServiceSettings{
+		DisabledTools: in.DisabledTools.Ptr(),
 	}
 }
 
@@ -390,6 +412,7 @@ func serialiseOwnedAccessKey(in *authentication.Authentication) openapi.OwnedAcc
 		ExpiresAt: in.Expires.Ptr(),
 		Enabled:   !in.Disabled,
 		Name:      in.Name.Or("Unnamed"),
+		Scopes:    serialiseAccessKeyScopes(access_key.ScopesFromMetadata(in.Metadata)),
 		CreatedBy: serialiseProfileReferenceFromAccount(in.Account),
 	}
 }
@@ -455,6 +478,21 @@ func serialiseAuditEvent(in *audit.AuditLog) openapi.AuditEvent {
 			AccountId: openapi.Identifier(accountID),
 			Included:  &included,
 		})
+
+	case audit.EventTypeAskerAbuseDetected:
+		rule, _ := in.Metadata["rule"].(string)
+		action, _ := in.Metadata["action"].(string)
+		var clientAddress *string
+		if addr, ok := in.Metadata["client_address"].(string); ok {
+			clientAddress = &addr
+		}
+
+		err = out.FromAuditEventAskerAbuseDetected(openapi.AuditEventAskerAbuseDetected{
+			Type:          openapi.AskerAbuseDetected,
+			Rule:          openapi.AskerAbuseRule(rule),
+			Action:        openapi.AskerAbuseAction(action),
+			ClientAddress: clientAddress,
+		})
 	}
 
 	if err != nil {
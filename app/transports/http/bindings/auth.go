@@ -169,7 +169,12 @@ func (a *Authentication) AccessKeyCreate(ctx context.Context, request openapi.Ac
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
 
-	aks, err := a.access_key.Create(ctx, accID, access_key.AccessKeyKindPersonal, request.Body.Name, opt.NewPtr(request.Body.ExpiresAt))
+	scopes, err := access_key.NewScopes(opt.NewPtr(request.Body.Scopes).OrZero())
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	aks, err := a.access_key.Create(ctx, accID, access_key.AccessKeyKindPersonal, request.Body.Name, opt.NewPtr(request.Body.ExpiresAt), scopes)
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx))
 	}
@@ -180,6 +185,7 @@ func (a *Authentication) AccessKeyCreate(ctx context.Context, request openapi.Ac
 			CreatedAt: aks.CreatedAt,
 			ExpiresAt: aks.Expires.Ptr(),
 			Name:      aks.Name,
+			Scopes:    serialiseAccessKeyScopes(aks.Scopes),
 			Secret:    aks.String(),
 		}),
 	}, nil
@@ -247,7 +253,16 @@ func serialiseAccessKey(k *authentication.Authentication) openapi.AccessKey {
 		ExpiresAt: k.Expires.Ptr(),
 		Enabled:   !k.Disabled,
 		Name:      k.Name.Or("Unnamed key"),
+		Scopes:    serialiseAccessKeyScopes(access_key.ScopesFromMetadata(k.Metadata)),
+	}
+}
+
+func serialiseAccessKeyScopes(in access_key.Scopes) *openapi.AccessKeyScopeList {
+	if !in.Restricted() {
+		return nil
 	}
+	scopes := in.Strings()
+	return &scopes
 }
 
 func serialiseAccessKeyList(list []*authentication.Authentication) []openapi.AccessKey {
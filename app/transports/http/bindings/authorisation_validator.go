@@ -51,6 +51,12 @@ func (i *Authorisation) validator(oapictx context.Context, ai *openapi3filter.Au
 		}
 	}
 
+	// Scoped access keys are issued for the MCP surface only, the scopes do not
+	// map onto API operations so the key is rejected outright here.
+	if session.GetAccessKeyScopes(ctx).Restricted() {
+		return fault.New("scoped access keys may only be used with MCP", fctx.With(ctx), ftag.With(ftag.PermissionDenied))
+	}
+
 	sessionRequired, perm := GetPermissionForOperation(op)
 	if perm == nil {
 		// No specific permission required, just need a session.
@@ -8,6 +8,7 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/Southclaws/dt"
 	"github.com/Southclaws/fault"
@@ -30,26 +31,35 @@ import (
 	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
 	"github.com/Southclaws/storyden/app/services/search/searcher"
 	"github.com/Southclaws/storyden/app/services/semdex"
+	"github.com/Southclaws/storyden/app/services/semdex/ask_guard"
 	"github.com/Southclaws/storyden/app/services/system/instance_info"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
 	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
 )
 
 type Datagraph struct {
+	heartbeat      time.Duration
 	searcher       searcher.Searcher
 	asker          semdex.Asker
+	guard          *ask_guard.Guard
 	accountQuerier *account_querier.Querier
 }
 
 func NewDatagraph(
+	cfg config.Config,
 	info *instance_info.Provider,
 	searcher searcher.Searcher,
 	asker semdex.Asker,
+	guard *ask_guard.Guard,
 	accountQuerier *account_querier.Querier,
 	router *echo.Echo,
 ) Datagraph {
 	d := Datagraph{
+		heartbeat:      cfg.AskerHeartbeatInterval,
 		searcher:       searcher,
 		asker:          asker,
+		guard:          guard,
 		accountQuerier: accountQuerier,
 	}
 
@@ -79,23 +89,36 @@ func NewDatagraph(
 					return fault.Wrap(err, fctx.With(ctx))
 				}
 
-				iter, err := d.asker.Ask(ctx, query, parent)
+				// Must match the rate limiter, echo's RealIP trusts any
+				// X-Forwarded-For header sent by the client.
+				address, err := limiter.ClientAddress(c.Request())
 				if err != nil {
 					return fault.Wrap(err, fctx.With(ctx))
 				}
 
-				w := c.Response().Writer
-
-				w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
-
-				// if fails to cast, do nothing, flusher nil, just guard clause.
-				flusher, ok := w.(http.Flusher)
-				if !ok {
-					if innerWriter := unwrapWriter(w); innerWriter != nil {
-						flusher, _ = innerWriter.(http.Flusher)
+				verdict := d.guard.Check(ctx, query, ask_guard.Client{
+					Address:   address,
+					UserAgent: c.Request().UserAgent(),
+				})
+				if verdict.Rejected() {
+					switch verdict.Action {
+					case ask_guard.ActionThrottle:
+						return echo.NewHTTPError(http.StatusTooManyRequests, "Too many questions, please try again later")
+					default:
+						return echo.NewHTTPError(http.StatusForbidden, "This question was rejected")
 					}
 				}
 
+				iter, err := d.asker.Ask(ctx, query, parent)
+				if err != nil {
+					return fault.Wrap(err, fctx.With(ctx))
+				}
+
+				stream := newEventStream(c.Response().Writer)
+
+				stop := stream.keepAlive(ctx, d.heartbeat)
+				defer stop()
+
 				for chunk, err := range iter {
 					if err != nil {
 						return err
@@ -121,23 +144,15 @@ func NewDatagraph(
 						msg = fmt.Sprintf("event: meta\ndata: %s\n\n", string(b))
 					}
 
-					if _, err := w.Write([]byte(msg)); err != nil {
+					if err := stream.send(msg); err != nil {
 						return err
 					}
-
-					if flusher != nil {
-						flusher.Flush()
-					}
 				}
 
-				if _, err := w.Write([]byte("event: end\n\n")); err != nil {
+				if err := stream.send("event: end\n\n"); err != nil {
 					return err
 				}
 
-				if flusher != nil {
-					flusher.Flush()
-				}
-
 				return nil
 			}
 
@@ -0,0 +1,93 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+var errEventStreamClosed = errors.New("event stream closed")
+
+// eventStream serialises writes to a text/event-stream response so that the
+// heartbeat goroutine never interleaves with the events written by a handler.
+type eventStream struct {
+	mu      sync.Mutex
+	w       http.ResponseWriter
+	flusher http.Flusher
+	closed  bool
+}
+
+func newEventStream(w http.ResponseWriter) *eventStream {
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+
+	// if fails to cast, do nothing, flusher nil, just guard clause.
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		if innerWriter := unwrapWriter(w); innerWriter != nil {
+			flusher, _ = innerWriter.(http.Flusher)
+		}
+	}
+
+	return &eventStream{w: w, flusher: flusher}
+}
+
+func (s *eventStream) send(msg string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return errEventStreamClosed
+	}
+
+	if _, err := s.w.Write([]byte(msg)); err != nil {
+		return err
+	}
+
+	if s.flusher != nil {
+		s.flusher.Flush()
+	}
+
+	return nil
+}
+
+// keepAlive periodically writes an SSE comment frame, which clients ignore, so
+// that the connection isn't considered idle while waiting for the model. No
+// frames are written if the interval is zero. The returned function must be
+// called before the handler returns.
+func (s *eventStream) keepAlive(ctx context.Context, interval time.Duration) func() {
+	done := make(chan struct{})
+
+	stop := func() {
+		close(done)
+
+		s.mu.Lock()
+		s.closed = true
+		s.mu.Unlock()
+	}
+
+	if interval <= 0 {
+		return stop
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := s.send(": ping\n\n"); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return stop
+}
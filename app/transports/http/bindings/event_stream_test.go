@@ -0,0 +1,120 @@
+package bindings
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// overlapWriter records whether two writes were ever in progress at once.
+type overlapWriter struct {
+	*httptest.ResponseRecorder
+	writing  atomic.Int32
+	overlaps atomic.Int32
+}
+
+func (w *overlapWriter) Write(b []byte) (int, error) {
+	if w.writing.Add(1) > 1 {
+		w.overlaps.Add(1)
+	}
+	defer w.writing.Add(-1)
+
+	time.Sleep(100 * time.Microsecond)
+
+	return w.ResponseRecorder.Write(b)
+}
+
+func TestEventStream(t *testing.T) {
+	t.Run("writes_ping_frames", func(t *testing.T) {
+		a := assert.New(t)
+
+		w := httptest.NewRecorder()
+		s := newEventStream(w)
+
+		stop := s.keepAlive(context.Background(), time.Millisecond)
+		time.Sleep(20 * time.Millisecond)
+		stop()
+
+		a.Equal("text/event-stream; charset=utf-8", w.Header().Get("Content-Type"))
+		a.NotEmpty(w.Body.String())
+		a.Empty(strings.ReplaceAll(w.Body.String(), ": ping\n\n", ""))
+		a.True(w.Flushed)
+	})
+
+	t.Run("zero_interval_disables_ping", func(t *testing.T) {
+		a := assert.New(t)
+
+		w := httptest.NewRecorder()
+		s := newEventStream(w)
+
+		stop := s.keepAlive(context.Background(), 0)
+		time.Sleep(10 * time.Millisecond)
+		a.NoError(s.send("data: hello\n\n"))
+		stop()
+
+		a.Equal("data: hello\n\n", w.Body.String())
+	})
+
+	t.Run("no_sends_after_stop", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		w := httptest.NewRecorder()
+		s := newEventStream(w)
+
+		stop := s.keepAlive(context.Background(), time.Millisecond)
+		r.NoError(s.send("data: hello\n\n"))
+		stop()
+
+		written := w.Body.String()
+
+		a.ErrorIs(s.send("data: late\n\n"), errEventStreamClosed)
+
+		time.Sleep(10 * time.Millisecond)
+		a.Equal(written, w.Body.String())
+	})
+
+	t.Run("writes_do_not_interleave", func(t *testing.T) {
+		a := assert.New(t)
+
+		w := &overlapWriter{ResponseRecorder: httptest.NewRecorder()}
+		s := newEventStream(w)
+
+		stop := s.keepAlive(context.Background(), 50*time.Microsecond)
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for range 20 {
+					a.NoError(s.send("data: chunk\n\n"))
+				}
+			}()
+		}
+		wg.Wait()
+		stop()
+
+		a.Zero(w.overlaps.Load())
+
+		frames := strings.Split(strings.TrimSuffix(w.Body.String(), "\n\n"), "\n\n")
+		chunks := 0
+		for _, f := range frames {
+			switch f {
+			case "data: chunk":
+				chunks++
+			case ": ping":
+			default:
+				t.Errorf("malformed frame %q", f)
+			}
+		}
+		a.Equal(200, chunks)
+	})
+}
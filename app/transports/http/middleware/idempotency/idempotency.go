@@ -0,0 +1,305 @@
+package idempotency
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+)
+
+const (
+	IdempotencyKey          = "Idempotency-Key"
+	IdempotentReplayed      = "Idempotent-Replayed"
+	MaxIdempotencyKeyLength = 255
+)
+
+// pendingTTL bounds how long a key stays locked if the process dies while the
+// original request is still being handled. The lock is refreshed for as long
+// as the handler runs, so slow requests hold it for longer than this.
+const pendingTTL = time.Minute
+
+type Middleware struct {
+	store   cache.Store
+	expiry  time.Duration
+	pending time.Duration
+}
+
+func New(cfg config.Config, store cache.Store) *Middleware {
+	return &Middleware{
+		store:   store,
+		expiry:  cfg.IdempotencyKeyExpiry,
+		pending: pendingTTL,
+	}
+}
+
+type record struct {
+	Pending     bool        `json:"pending,omitempty"`
+	Fingerprint string      `json:"fingerprint"`
+	Status      int         `json:"status,omitempty"`
+	Header      http.Header `json:"header,omitempty"`
+	Body        []byte      `json:"body,omitempty"`
+}
+
+// WithIdempotency stores the response to mutating requests which carry an
+// Idempotency-Key header so that a retried request returns the same response
+// instead of performing the operation twice. It must run after the session
+// middleware as keys are scoped to the account making the request.
+func (m *Middleware) WithIdempotency() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			key := r.Header.Get(IdempotencyKey)
+			if key == "" || !isMutating(r.Method) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if len(key) > MaxIdempotencyKeyLength {
+				http.Error(w, "Idempotency-Key header is too long", http.StatusBadRequest)
+				return
+			}
+
+			// Guests have nothing to scope a key to, and sharing keys between
+			// anonymous clients would replay one client's response to another.
+			accountID, ok := session.GetOptAccountID(ctx).Get()
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			cacheKey := "idempotency:" + accountID.String() + ":" + key
+			lockKey := cacheKey + ":lock"
+			fingerprint := fingerprint(r, body)
+
+			if existing, ok := m.get(r, cacheKey); ok && !existing.Pending {
+				respond(w, existing, fingerprint)
+				return
+			}
+
+			// The lock is claimed atomically, along with its expiry, so that
+			// only one of several concurrent requests with the same key ever
+			// runs the handler and a crash never leaves the key locked.
+			claimed, err := m.store.SetNX(ctx, lockKey, fingerprint, m.pending)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !claimed {
+				existing, ok := m.get(r, cacheKey)
+				if !ok {
+					existing = record{Pending: true, Fingerprint: fingerprint}
+				}
+				respond(w, existing, fingerprint)
+				return
+			}
+
+			if err := m.put(r, cacheKey, record{Pending: true, Fingerprint: fingerprint}, m.pending); err != nil {
+				m.release(r, cacheKey, lockKey)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// Headers set by earlier middleware, such as CORS and rate limits,
+			// belong to each individual request and are never replayed.
+			preceding := w.Header().Clone()
+
+			rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+
+			m.serve(rec, r, next, cacheKey, lockKey)
+
+			// Server errors are usually transient, so the key is released to
+			// allow the client to retry rather than replaying the failure.
+			if rec.status >= http.StatusInternalServerError || rec.streamed {
+				m.release(r, cacheKey, lockKey)
+				return
+			}
+
+			err = m.put(r, cacheKey, record{
+				Fingerprint: fingerprint,
+				Status:      rec.status,
+				Header:      replayableHeaders(rec.Header(), preceding),
+				Body:        rec.body.Bytes(),
+			}, m.expiry)
+			if err != nil {
+				m.release(r, cacheKey, lockKey)
+				return
+			}
+
+			// The lock is held for as long as the response is stored, any
+			// later claim then finds and replays the stored response. If this
+			// fails the lock still lapses with the pending expiry, after which
+			// retries find the stored response before trying to claim the key.
+			m.store.Expire(ctx, lockKey, m.expiry)
+		})
+	}
+}
+
+// serve runs the handler while holding the lock, refreshing the lock and the
+// pending record so that a retry which arrives while a slow request is still
+// running is not able to claim the key. If the handler panics, the key is
+// released before the panic continues up to the recovery middleware.
+func (m *Middleware) serve(w http.ResponseWriter, r *http.Request, next http.Handler, key, lockKey string) {
+	ctx := r.Context()
+
+	done := make(chan struct{})
+	refreshed := make(chan struct{})
+
+	go func() {
+		defer close(refreshed)
+
+		ticker := time.NewTicker(m.pending / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				m.store.Expire(ctx, lockKey, m.pending)
+				m.store.Expire(ctx, key, m.pending)
+			}
+		}
+	}()
+
+	completed := false
+	defer func() {
+		close(done)
+		<-refreshed
+
+		if !completed {
+			m.release(r, key, lockKey)
+		}
+	}()
+
+	next.ServeHTTP(w, r)
+
+	completed = true
+}
+
+func (m *Middleware) get(r *http.Request, key string) (record, bool) {
+	raw, err := m.store.Get(r.Context(), key)
+	if err != nil {
+		return record{}, false
+	}
+
+	var rec record
+	if err := json.Unmarshal([]byte(raw), &rec); err != nil {
+		return record{}, false
+	}
+
+	return rec, true
+}
+
+func (m *Middleware) put(r *http.Request, key string, rec record, ttl time.Duration) error {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return m.store.Set(r.Context(), key, string(b), ttl)
+}
+
+func (m *Middleware) release(r *http.Request, key, lockKey string) {
+	m.store.Delete(r.Context(), key)
+	m.store.Delete(r.Context(), lockKey)
+}
+
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return true
+	default:
+		return false
+	}
+}
+
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method))
+	h.Write([]byte(r.URL.RequestURI()))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replayableHeaders(h http.Header, preceding http.Header) http.Header {
+	out := http.Header{}
+	for k, v := range h {
+		if _, ok := preceding[k]; ok {
+			continue
+		}
+		out[k] = v
+	}
+
+	// Session cookies must only ever be issued to the request which actually
+	// authenticated, never to a retry.
+	out.Del("Set-Cookie")
+
+	return out
+}
+
+func respond(w http.ResponseWriter, existing record, fingerprint string) {
+	switch {
+	case existing.Fingerprint != fingerprint:
+		http.Error(w, "Idempotency-Key was already used for a different request", http.StatusUnprocessableEntity)
+	case existing.Pending:
+		http.Error(w, "A request with this Idempotency-Key is still being processed", http.StatusConflict)
+	default:
+		replay(w, existing)
+	}
+}
+
+func replay(w http.ResponseWriter, rec record) {
+	for k, v := range rec.Header {
+		w.Header()[k] = v
+	}
+	w.Header().Set(IdempotentReplayed, "true")
+	w.WriteHeader(rec.Status)
+	w.Write(rec.Body)
+}
+
+// recorder captures the response while still writing it through to the client.
+type recorder struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	streamed bool
+}
+
+func (r *recorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func (r *recorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// Flush marks the response as streamed, streamed responses are not stored as
+// replaying a partial event stream would be meaningless to the client.
+func (r *recorder) Flush() {
+	r.streamed = true
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
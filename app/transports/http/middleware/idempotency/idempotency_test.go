@@ -0,0 +1,262 @@
+package idempotency
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache"
+	"github.com/Southclaws/storyden/internal/infrastructure/cache/local"
+)
+
+func newTestStore(t *testing.T) cache.Store {
+	store, err := local.New()
+	require.NoError(t, err)
+	return store
+}
+
+// slowGetStore widens the window between checking for a stored response and
+// claiming the key, which is where concurrent retries would race.
+type slowGetStore struct {
+	cache.Store
+}
+
+func (s slowGetStore) Get(ctx context.Context, key string) (string, error) {
+	v, err := s.Store.Get(ctx, key)
+	time.Sleep(10 * time.Millisecond)
+	return v, err
+}
+
+func newTestMiddleware(t *testing.T, store cache.Store, handler http.HandlerFunc, opts ...func(*Middleware)) http.Handler {
+	m := New(config.Config{IdempotencyKeyExpiry: time.Hour}, store)
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	acc := account.Account{ID: account.AccountID(xid.New())}
+
+	h := m.WithIdempotency()(handler)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(session.WithAccount(r.Context(), acc, role.Roles{})))
+	})
+}
+
+func do(h http.Handler, key, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/api/threads", strings.NewReader(body))
+	r.Header.Set(IdempotencyKey, key)
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestIdempotency(t *testing.T) {
+	t.Run("replays_stored_response", func(t *testing.T) {
+		a := assert.New(t)
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.Header().Set("Location", "/api/threads/1")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"1"}`))
+		})
+
+		first := do(h, "k1", `{"title":"hello"}`)
+		a.Equal(http.StatusCreated, first.Code)
+		a.Empty(first.Header().Get(IdempotentReplayed))
+
+		second := do(h, "k1", `{"title":"hello"}`)
+		a.Equal(http.StatusCreated, second.Code)
+		a.Equal(`{"id":"1"}`, second.Body.String())
+		a.Equal("/api/threads/1", second.Header().Get("Location"))
+		a.Equal("true", second.Header().Get(IdempotentReplayed))
+
+		a.Equal(int32(1), calls.Load())
+	})
+
+	t.Run("rejects_fingerprint_mismatch", func(t *testing.T) {
+		a := assert.New(t)
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		a.Equal(http.StatusCreated, do(h, "k1", `{"title":"hello"}`).Code)
+		a.Equal(http.StatusUnprocessableEntity, do(h, "k1", `{"title":"goodbye"}`).Code)
+
+		a.Equal(int32(1), calls.Load())
+	})
+
+	t.Run("conflicts_while_pending", func(t *testing.T) {
+		a := assert.New(t)
+
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				close(started)
+			}
+			<-unblock
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- do(h, "k1", `{}`) }()
+		<-started
+
+		a.Equal(http.StatusConflict, do(h, "k1", `{}`).Code)
+		a.Equal(http.StatusUnprocessableEntity, do(h, "k1", `{"other":true}`).Code)
+
+		close(unblock)
+		a.Equal(http.StatusCreated, (<-done).Code)
+
+		a.Equal(int32(1), calls.Load())
+	})
+
+	t.Run("concurrent_retries_run_once", func(t *testing.T) {
+		a := assert.New(t)
+
+		unblock := make(chan struct{})
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, slowGetStore{newTestStore(t)}, func(w http.ResponseWriter, r *http.Request) {
+			calls.Add(1)
+			<-unblock
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		var wg sync.WaitGroup
+		codes := make(chan int, 20)
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				codes <- do(h, "k1", `{}`).Code
+			}()
+		}
+
+		// Every request except the one holding the lock returns immediately.
+		for range 19 {
+			select {
+			case code := <-codes:
+				a.Equal(http.StatusConflict, code)
+			case <-time.After(time.Second):
+				close(unblock)
+				t.Fatalf("handler ran %d times for one key", calls.Load())
+			}
+		}
+
+		close(unblock)
+		wg.Wait()
+
+		a.Equal(http.StatusCreated, <-codes)
+		a.Equal(int32(1), calls.Load())
+	})
+
+	t.Run("holds_lock_while_slow_handler_runs", func(t *testing.T) {
+		a := assert.New(t)
+
+		started := make(chan struct{})
+		unblock := make(chan struct{})
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				close(started)
+			}
+			<-unblock
+			w.WriteHeader(http.StatusCreated)
+		}, func(m *Middleware) {
+			m.pending = 30 * time.Millisecond
+		})
+
+		done := make(chan *httptest.ResponseRecorder)
+		go func() { done <- do(h, "k1", `{}`) }()
+		<-started
+
+		// Well past the pending expiry, the original request still holds the
+		// key because the lock is refreshed while the handler runs.
+		time.Sleep(150 * time.Millisecond)
+
+		retry := make(chan *httptest.ResponseRecorder)
+		go func() { retry <- do(h, "k1", `{}`) }()
+
+		select {
+		case w := <-retry:
+			a.Equal(http.StatusConflict, w.Code)
+		case <-time.After(time.Second):
+			close(unblock)
+			t.Fatalf("handler ran %d times for one key", calls.Load())
+		}
+
+		close(unblock)
+		a.Equal(http.StatusCreated, (<-done).Code)
+
+		replayed := do(h, "k1", `{}`)
+		a.Equal(http.StatusCreated, replayed.Code)
+		a.Equal("true", replayed.Header().Get(IdempotentReplayed))
+
+		a.Equal(int32(1), calls.Load())
+	})
+
+	t.Run("releases_key_after_panic", func(t *testing.T) {
+		a := assert.New(t)
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				panic("boom")
+			}
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		a.PanicsWithValue("boom", func() { do(h, "k1", `{}`) })
+
+		second := do(h, "k1", `{}`)
+		a.Equal(http.StatusCreated, second.Code)
+		a.Empty(second.Header().Get(IdempotentReplayed))
+
+		a.Equal(int32(2), calls.Load())
+	})
+
+	t.Run("releases_key_after_server_error", func(t *testing.T) {
+		a := assert.New(t)
+
+		var calls atomic.Int32
+		h := newTestMiddleware(t, newTestStore(t), func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		})
+
+		a.Equal(http.StatusInternalServerError, do(h, "k1", `{}`).Code)
+
+		second := do(h, "k1", `{}`)
+		a.Equal(http.StatusCreated, second.Code)
+		a.Empty(second.Header().Get(IdempotentReplayed))
+
+		a.Equal(int32(2), calls.Load())
+	})
+}
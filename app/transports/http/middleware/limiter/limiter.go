@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Southclaws/storyden/app/services/authentication/session"
 	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/rate"
 )
@@ -19,7 +20,7 @@ const (
 )
 
 type Middleware struct {
-	rl        rate.Limiter
+	buckets   map[Group]map[Tier]rate.Limiter
 	kf        KeyFunc
 	sizeLimit int64
 }
@@ -29,21 +30,33 @@ func New(
 
 	f *rate.LimiterFactory,
 ) *Middleware {
-	rl := f.NewLimiter(cfg.RateLimit, cfg.RateLimitPeriod, cfg.RateLimitExpire)
+	buckets := map[Group]map[Tier]rate.Limiter{}
+	for _, g := range []Group{GroupAPI, GroupMCP} {
+		buckets[g] = map[Tier]rate.Limiter{}
+		for _, t := range tiers {
+			buckets[g][t] = f.NewLimiter(limitFor(cfg, g, t), cfg.RateLimitPeriod, cfg.RateLimitExpire)
+		}
+	}
 
 	return &Middleware{
-		rl:        rl,
-		kf:        fromIP("CF-Connecting-IP", "X-Real-IP", "True-Client-IP"),
+		buckets:   buckets,
+		kf:        ClientAddress,
 		sizeLimit: MaxRequestSizeBytes, // TODO: cfg.MaxRequestSize
 	}
 }
 
-func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
+// WithRateLimit must be applied after the session middleware as the bucket a
+// request is counted against depends on who is making it.
+func (m *Middleware) WithRateLimit(group Group) func(next http.Handler) http.Handler {
+	buckets := m.buckets[group]
+
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			ctx := r.Context()
 
-			key, err := m.kf(r)
+			tier := tierFromContext(ctx)
+
+			key, err := m.key(r)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
@@ -52,7 +65,7 @@ func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 			// TODO: Generate costs per-operation from OpenAPI spec
 			cost := 1
 
-			status, allowed, err := m.rl.Increment(ctx, key, cost)
+			status, allowed, err := buckets[tier].Increment(ctx, string(group)+":"+string(tier)+":"+key, cost)
 			if err != nil {
 				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 				return
@@ -77,8 +90,23 @@ func (m *Middleware) WithRateLimit() func(next http.Handler) http.Handler {
 	}
 }
 
+// key identifies members by their account so that members sharing an address
+// do not share a budget, guests can only be identified by their IP address.
+func (m *Middleware) key(r *http.Request) (string, error) {
+	if accountID, ok := session.GetOptAccountID(r.Context()).Get(); ok {
+		return accountID.String(), nil
+	}
+
+	return m.kf(r)
+}
+
 type KeyFunc func(r *http.Request) (string, error)
 
+// ClientAddress identifies a guest by the address set by a known reverse proxy
+// or CDN, falling back to the connection's address. Headers such as
+// X-Forwarded-For are not used as any client can set them to any value.
+var ClientAddress KeyFunc = fromIP("CF-Connecting-IP", "X-Real-IP", "True-Client-IP")
+
 func fromIP(headers ...string) KeyFunc {
 	return func(r *http.Request) (string, error) {
 		for _, h := range headers {
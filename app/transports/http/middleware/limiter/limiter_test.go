@@ -0,0 +1,30 @@
+package limiter
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientAddress(t *testing.T) {
+	address := func(headers map[string]string) string {
+		r := httptest.NewRequest("GET", "/api/datagraph/ask", nil)
+		r.RemoteAddr = "192.0.2.1:51234"
+		for k, v := range headers {
+			r.Header.Set(k, v)
+		}
+
+		a, err := ClientAddress(r)
+		require.NoError(t, err)
+		return a
+	}
+
+	a := assert.New(t)
+
+	a.Equal("192.0.2.1", address(nil))
+	a.Equal("192.0.2.1", address(map[string]string{"X-Forwarded-For": "203.0.113.7"}))
+	a.Equal("198.51.100.2", address(map[string]string{"X-Real-IP": "198.51.100.2"}))
+	a.Equal("198.51.100.3", address(map[string]string{"CF-Connecting-IP": "198.51.100.3", "X-Real-IP": "198.51.100.2"}))
+}
@@ -0,0 +1,101 @@
+package limiter
+
+import (
+	"context"
+
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// Group separates the rate limit buckets of different route groups so traffic
+// to one, such as MCP, does not consume the budget of another.
+type Group string
+
+const (
+	GroupAPI Group = "api"
+	GroupMCP Group = "mcp"
+)
+
+type Tier string
+
+const (
+	TierGuest     Tier = "guest"
+	TierMember    Tier = "member"
+	TierModerator Tier = "moderator"
+	TierAdmin     Tier = "admin"
+	TierAccessKey Tier = "access_key"
+)
+
+var tiers = []Tier{TierGuest, TierMember, TierModerator, TierAdmin, TierAccessKey}
+
+var moderatorPermissions = []rbac.Permission{
+	rbac.PermissionManagePosts,
+	rbac.PermissionManageCategories,
+	rbac.PermissionManageLibrary,
+	rbac.PermissionManageEvents,
+	rbac.PermissionManageCollections,
+	rbac.PermissionManageSuspensions,
+}
+
+func tierFromContext(ctx context.Context) Tier {
+	if !session.GetOptAccountID(ctx).Ok() {
+		return TierGuest
+	}
+
+	if scheme, err := session.GetSecurityScheme(ctx); err == nil && scheme == "access_key" {
+		return TierAccessKey
+	}
+
+	perms := session.GetRoles(ctx).Permissions()
+
+	switch {
+	case perms.HasAny(rbac.PermissionAdministrator):
+		return TierAdmin
+	case perms.HasAny(moderatorPermissions...):
+		return TierModerator
+	default:
+		return TierMember
+	}
+}
+
+func groupLimit(cfg config.Config, g Group) int {
+	if g == GroupMCP && cfg.RateLimitMCP > 0 {
+		return cfg.RateLimitMCP
+	}
+
+	return cfg.RateLimit
+}
+
+func tierLimit(cfg config.Config, t Tier) int {
+	switch t {
+	case TierGuest:
+		return cfg.RateLimitGuest
+	case TierMember:
+		return cfg.RateLimitMember
+	case TierModerator:
+		return cfg.RateLimitModerator
+	case TierAdmin:
+		return cfg.RateLimitAdmin
+	case TierAccessKey:
+		return cfg.RateLimitAccessKey
+	default:
+		return 0
+	}
+}
+
+// limitFor resolves the limit for a tier within a route group. A tier-specific
+// limit replaces the base RATE_LIMIT, but RATE_LIMIT_MCP caps every tier on the
+// MCP server so each tier can be given a tighter MCP budget than its API one.
+func limitFor(cfg config.Config, g Group, t Tier) int {
+	l := tierLimit(cfg, t)
+	if l <= 0 {
+		return groupLimit(cfg, g)
+	}
+
+	if g == GroupMCP && cfg.RateLimitMCP > 0 {
+		return min(l, cfg.RateLimitMCP)
+	}
+
+	return l
+}
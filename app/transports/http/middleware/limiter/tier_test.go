@@ -0,0 +1,57 @@
+package limiter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func TestLimitFor(t *testing.T) {
+	r := require.New(t)
+
+	cfg := config.Config{
+		RateLimit:      1000,
+		RateLimitGuest: 100,
+		RateLimitAdmin: 5000,
+	}
+
+	r.Equal(100, limitFor(cfg, GroupAPI, TierGuest))
+	r.Equal(1000, limitFor(cfg, GroupAPI, TierMember))
+	r.Equal(5000, limitFor(cfg, GroupAPI, TierAdmin))
+	r.Equal(1000, limitFor(cfg, GroupMCP, TierAccessKey))
+
+	cfg.RateLimitMCP = 200
+
+	r.Equal(200, limitFor(cfg, GroupMCP, TierAccessKey))
+	r.Equal(100, limitFor(cfg, GroupMCP, TierGuest))
+	r.Equal(1000, limitFor(cfg, GroupAPI, TierAccessKey))
+}
+
+func TestLimitForTierAndGroup(t *testing.T) {
+	r := require.New(t)
+
+	cfg := config.Config{
+		RateLimit:       1000,
+		RateLimitMember: 500,
+		RateLimitAdmin:  5000,
+		RateLimitMCP:    200,
+	}
+
+	// Members get their own budget on the API and are capped on MCP.
+	r.Equal(500, limitFor(cfg, GroupAPI, TierMember))
+	r.Equal(200, limitFor(cfg, GroupMCP, TierMember))
+
+	// A tier limit below the MCP limit applies to both groups.
+	cfg.RateLimitMember = 50
+	r.Equal(50, limitFor(cfg, GroupAPI, TierMember))
+	r.Equal(50, limitFor(cfg, GroupMCP, TierMember))
+
+	r.Equal(5000, limitFor(cfg, GroupAPI, TierAdmin))
+	r.Equal(200, limitFor(cfg, GroupMCP, TierAdmin))
+
+	// Tiers without a limit fall back to each group's base limit.
+	r.Equal(1000, limitFor(cfg, GroupAPI, TierModerator))
+	r.Equal(200, limitFor(cfg, GroupMCP, TierModerator))
+}
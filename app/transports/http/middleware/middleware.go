@@ -6,6 +6,7 @@ import (
 	"github.com/Southclaws/storyden/app/transports/http/middleware/chaos"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/frontend"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/headers"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/idempotency"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
@@ -14,12 +15,14 @@ import (
 
 func Build() fx.Option {
 	return fx.Provide(
+		origin.NewAllowList,
 		origin.New,
 		reqlog.New,
 		frontend.New,
 		headers.New,
 		session_cookie.New,
 		limiter.New,
+		idempotency.New,
 		chaos.New,
 	)
 }
@@ -0,0 +1,97 @@
+package origin
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fmsg"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+// AllowList holds the origins, from the public addresses and from the allowed
+// origins configuration, which Storyden trusts to make requests to the API.
+type AllowList struct {
+	any       bool
+	exact     map[string]struct{}
+	wildcards []wildcard
+}
+
+// wildcard matches any subdomain below suffix, such as ".example.com", with
+// the same scheme. The suffix includes the port, if the pattern specified one.
+type wildcard struct {
+	scheme string
+	suffix string
+}
+
+func NewAllowList(cfg config.Config) (*AllowList, error) {
+	al := &AllowList{
+		exact: map[string]struct{}{
+			Of(cfg.PublicWebAddress): {},
+			Of(cfg.PublicAPIAddress): {},
+		},
+	}
+
+	if strings.TrimSpace(cfg.CORSAllowedOrigins) == "" {
+		al.any = true
+		return al, nil
+	}
+
+	for _, entry := range strings.Split(cfg.CORSAllowedOrigins, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		u, err := url.Parse(entry)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fault.New("invalid origin: "+entry, fmsg.With("CORS_ALLOWED_ORIGINS must be a list of origins such as https://example.com"))
+		}
+
+		host, isWildcard := strings.CutPrefix(u.Host, "*.")
+		if strings.Contains(host, "*") {
+			return nil, fault.New("invalid origin: "+entry, fmsg.With("CORS_ALLOWED_ORIGINS wildcards are only supported as the first subdomain"))
+		}
+
+		if isWildcard {
+			al.wildcards = append(al.wildcards, wildcard{scheme: u.Scheme, suffix: "." + host})
+			continue
+		}
+
+		al.exact[Of(*u)] = struct{}{}
+	}
+
+	return al, nil
+}
+
+// Of returns the origin, the scheme and host, of a URL.
+func Of(u url.URL) string {
+	return u.Scheme + "://" + u.Host
+}
+
+// Allows reports whether the origin may make cross-origin requests.
+func (al *AllowList) Allows(origin string) bool {
+	return al.any || al.Trusted(origin)
+}
+
+// Trusted reports whether the origin was explicitly configured, regardless of
+// whether any origin is allowed to make cross-origin requests.
+func (al *AllowList) Trusted(origin string) bool {
+	if _, ok := al.exact[origin]; ok {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return false
+	}
+
+	for _, w := range al.wildcards {
+		if u.Scheme == w.scheme && strings.HasSuffix(u.Host, w.suffix) && len(u.Host) > len(w.suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package origin
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func TestAllowList(t *testing.T) {
+	web, _ := url.Parse("https://example.com")
+	api, _ := url.Parse("https://api.example.com")
+
+	cfg := config.Config{
+		PublicWebAddress: *web,
+		PublicAPIAddress: *api,
+	}
+
+	t.Run("any_when_unset", func(t *testing.T) {
+		al, err := NewAllowList(cfg)
+		require.NoError(t, err)
+
+		assert.True(t, al.Allows("https://anything.test"))
+		assert.False(t, al.Trusted("https://anything.test"))
+		assert.True(t, al.Trusted("https://example.com"))
+	})
+
+	t.Run("listed_and_wildcards", func(t *testing.T) {
+		cfg := cfg
+		cfg.CORSAllowedOrigins = "https://admin.example.org, https://*.preview.example.com,https://*.dev.example.com:8443"
+
+		al, err := NewAllowList(cfg)
+		require.NoError(t, err)
+
+		assert.True(t, al.Allows("https://example.com"))
+		assert.True(t, al.Allows("https://api.example.com"))
+		assert.True(t, al.Allows("https://admin.example.org"))
+		assert.True(t, al.Allows("https://pr-1.preview.example.com"))
+		assert.True(t, al.Allows("https://a.b.preview.example.com"))
+		assert.True(t, al.Allows("https://x.dev.example.com:8443"))
+
+		assert.False(t, al.Allows("https://preview.example.com"))
+		assert.False(t, al.Allows("http://pr-1.preview.example.com"))
+		assert.False(t, al.Allows("https://evilpreview.example.com"))
+		assert.False(t, al.Allows("https://x.dev.example.com"))
+		assert.False(t, al.Allows("https://anything.test"))
+		assert.False(t, al.Allows("null"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cfg := cfg
+		cfg.CORSAllowedOrigins = "example.com"
+		_, err := NewAllowList(cfg)
+		assert.Error(t, err)
+
+		cfg.CORSAllowedOrigins = "https://a.*.example.com"
+		_, err = NewAllowList(cfg)
+		assert.Error(t, err)
+	})
+}
@@ -4,17 +4,17 @@ import (
 	"net/http"
 
 	"github.com/rs/cors"
-	"github.com/samber/lo"
 
 	"github.com/Southclaws/storyden/internal/config"
 )
 
 type Middleware struct {
-	cfg config.Config
+	cfg   config.Config
+	allow *AllowList
 }
 
-func New(cfg config.Config) *Middleware {
-	return &Middleware{cfg: cfg}
+func New(cfg config.Config, allow *AllowList) *Middleware {
+	return &Middleware{cfg: cfg, allow: allow}
 }
 
 func (m *Middleware) WithCORS() func(next http.Handler) http.Handler {
@@ -35,6 +35,9 @@ func (m *Middleware) WithCORS() func(next http.Handler) http.Handler {
 		"X-CSRF-Token",
 		"X-Correlation-ID",
 		"X-Forwarded-Host",
+		"Idempotency-Key",
+		"Mcp-Session-Id",
+		"Mcp-Protocol-Version",
 	}
 
 	exposedHeaders := []string{
@@ -43,28 +46,18 @@ func (m *Middleware) WithCORS() func(next http.Handler) http.Handler {
 		"Content-Length",
 		"X-Ratelimit-Limit",
 		"X-Ratelimit-Reset",
+		"Idempotent-Replayed",
+		"Mcp-Session-Id",
 	}
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			origin := r.Header.Get("Origin")
 
-			// TODO: Provide a way to set multiple allowed origins via config.
-			// NOTE: Currently, we allow all origins but not via "*".
-			allowedOrigins := []string{
-				"http://localhost:3000",
-				origin,
-			}
-
-			table := lo.SliceToMap(allowedOrigins, func(s string) (string, struct{}) { return s, struct{}{} })
-
-			allowOriginFunc := func(origin string) bool {
-				_, present := table[origin]
-				return present
-			}
-
+			// NOTE: When no origins are configured, we allow all origins but
+			// not via "*" as that would not permit credentials.
 			corsConfig := cors.New(cors.Options{
-				AllowOriginFunc:  allowOriginFunc,
+				AllowOriginFunc:  m.allow.Allows,
 				AllowedMethods:   allowedMethods,
 				AllowedHeaders:   allowedHeaders,
 				ExposedHeaders:   exposedHeaders,
@@ -0,0 +1,62 @@
+package reqlog
+
+import (
+	"net/http"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Query parameters which are never logged on any route.
+var sensitiveParams = []string{
+	"token",
+	"access_token",
+	"refresh_token",
+	"id_token",
+	"code",
+	"state",
+	"key",
+	"api_key",
+	"password",
+	"secret",
+	"signature",
+}
+
+// Query parameters which carry member-written content on specific routes, such
+// as the questions members ask the Asker which are effectively chat messages.
+var sensitiveRouteParams = map[string][]string{
+	"/api/datagraph/ask": {"q"},
+}
+
+func redactQuery(r *http.Request) string {
+	query := r.URL.Query()
+	if len(query) == 0 {
+		return ""
+	}
+
+	for name := range query {
+		if isSensitiveParam(r.URL.Path, name) {
+			query[name] = []string{redacted}
+		}
+	}
+
+	return query.Encode()
+}
+
+func isSensitiveParam(path string, name string) bool {
+	lower := strings.ToLower(name)
+
+	for _, p := range sensitiveParams {
+		if lower == p {
+			return true
+		}
+	}
+
+	for _, p := range sensitiveRouteParams[path] {
+		if lower == p {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,31 @@
+package reqlog
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactQuery(t *testing.T) {
+	a := assert.New(t)
+
+	r := httptest.NewRequest("GET", "/api/datagraph/ask?q=how+do+i+reset+my+password&parent_question_id=abc", nil)
+	q, _ := url.ParseQuery(redactQuery(r))
+	a.Equal(redacted, q.Get("q"))
+	a.Equal("abc", q.Get("parent_question_id"))
+
+	r = httptest.NewRequest("GET", "/api/datagraph?q=bikes", nil)
+	q, _ = url.ParseQuery(redactQuery(r))
+	a.Equal("bikes", q.Get("q"))
+
+	r = httptest.NewRequest("GET", "/api/auth/oauth/github/callback?Code=xyz&state=abc&page=2", nil)
+	q, _ = url.ParseQuery(redactQuery(r))
+	a.Equal(redacted, q.Get("Code"))
+	a.Equal(redacted, q.Get("state"))
+	a.Equal("2", q.Get("page"))
+
+	r = httptest.NewRequest("GET", "/api/threads", nil)
+	a.Equal("", redactQuery(r))
+}
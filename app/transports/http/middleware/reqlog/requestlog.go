@@ -3,22 +3,29 @@ package reqlog
 import (
 	"fmt"
 	"log/slog"
+	"math/rand/v2"
 	"net/http"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/internal/config"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/kv"
 	"github.com/Southclaws/storyden/internal/infrastructure/instrumentation/spanner"
 )
 
 type Middleware struct {
-	ins spanner.Instrumentation
+	ins            spanner.Instrumentation
+	sampling       int
+	streamSampling int
 }
 
-func New(ins spanner.Builder) *Middleware {
+func New(cfg config.Config, ins spanner.Builder) *Middleware {
 	return &Middleware{
-		ins: ins.Build(),
+		ins:            ins.Build(),
+		sampling:       cfg.LogRequestSampling,
+		streamSampling: cfg.LogStreamSampling,
 	}
 }
 
@@ -36,6 +43,20 @@ func (lrw *withStatus) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
+// shouldLog samples successful requests, failures are always logged.
+func (m *Middleware) shouldLog(w *withStatus) bool {
+	if w.statusCode >= http.StatusBadRequest {
+		return true
+	}
+
+	n := m.sampling
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		n = m.streamSampling
+	}
+
+	return n <= 1 || rand.IntN(n) == 0
+}
+
 func (m *Middleware) WithLogger() func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -52,7 +73,7 @@ func (m *Middleware) WithLogger() func(http.Handler) http.Handler {
 				kv.String("http.request.header.origin", origin),
 				kv.String("client.address", r.RemoteAddr),
 				kv.String("http.request.method", r.Method),
-				kv.String("url.query", r.URL.Query().Encode()),
+				kv.String("url.query", redactQuery(r)),
 				kv.Int("http.request.body.size", int(r.ContentLength)),
 			)
 			defer span.End()
@@ -65,9 +86,13 @@ func (m *Middleware) WithLogger() func(http.Handler) http.Handler {
 
 				logger := span.Logger()
 
-				logger.Info(title)
+				recovery := recover()
+
+				if recovery != nil || m.shouldLog(wr) {
+					logger.Info(title)
+				}
 
-				if recovery := recover(); recovery != nil {
+				if recovery != nil {
 					err := func(v any) error {
 						if e, ok := v.(error); ok {
 							return e
@@ -6,12 +6,14 @@ package session_cookie
 import (
 	"context"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/Southclaws/fault"
 	"github.com/Southclaws/fault/fmsg"
 	"github.com/Southclaws/storyden/app/resources/account/token"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
 	"github.com/Southclaws/storyden/internal/config"
 )
 
@@ -31,9 +33,10 @@ type Jar struct {
 	issuer           *session.Issuer
 	domain           string
 	secureCookieName string
+	allow            *origin.AllowList
 }
 
-func New(cfg config.Config, v *session.Validator) (*Jar, error) {
+func New(cfg config.Config, v *session.Validator, allow *origin.AllowList) (*Jar, error) {
 	domain, err := getCookieDomain(cfg.PublicAPIAddress, cfg.PublicWebAddress)
 	if err != nil {
 		return nil, fault.Wrap(err, fmsg.With("failed to parse domain from public API address"))
@@ -43,6 +46,7 @@ func New(cfg config.Config, v *session.Validator) (*Jar, error) {
 		domain:           domain,
 		validator:        v,
 		secureCookieName: secureCookieName,
+		allow:            allow,
 	}, nil
 }
 
@@ -72,17 +76,18 @@ func (j *Jar) Destroy() *http.Cookie {
 }
 
 // withSession checks the request for a session via either a cookie (for browser
-// requests) or a bearer token access key (for API requests).
-func (j *Jar) withSession(r *http.Request) context.Context {
+// requests) or a bearer token access key (for API requests). The boolean result
+// is true if the session was read from a cookie.
+func (j *Jar) withSession(r *http.Request) (context.Context, bool) {
 	if ctx, ok := j.tryFromCookie(r); ok {
-		return ctx
+		return ctx, true
 	}
 
 	if ctx, ok := j.tryFromHeader(r); ok {
-		return ctx
+		return ctx, false
 	}
 
-	return j.withDefaultRoles(r)
+	return j.withDefaultRoles(r), false
 }
 
 func (j *Jar) tryFromCookie(r *http.Request) (context.Context, bool) {
@@ -100,17 +105,12 @@ func (j *Jar) tryFromCookie(r *http.Request) (context.Context, bool) {
 }
 
 func (j *Jar) tryFromHeader(r *http.Request) (context.Context, bool) {
-	authHeader := r.Header.Get("Authorization")
-	if authHeader == "" {
+	token, ok := bearerToken(r.Header.Get("Authorization"))
+	if !ok {
 		return r.Context(), false
 	}
 
-	// The header should be in the format "Bearer <token>".
-	if len(authHeader) < 7 || authHeader[:7] != "Bearer " {
-		return r.Context(), false
-	}
-
-	ctx, err := j.validator.ValidateAccessKeyToken(r.Context(), authHeader[7:])
+	ctx, err := j.validator.ValidateAccessKeyToken(r.Context(), token)
 	if err != nil {
 		return r.Context(), false
 	}
@@ -118,6 +118,22 @@ func (j *Jar) tryFromHeader(r *http.Request) (context.Context, bool) {
 	return ctx, true
 }
 
+// bearerToken extracts the token from an "Authorization: Bearer <token>" header.
+// The scheme is case-insensitive as per RFC 9110, so "bearer" is also accepted.
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(strings.TrimSpace(header), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
 func (j *Jar) withDefaultRoles(r *http.Request) context.Context {
 	ctx, err := j.validator.WithUnauthenticatedRoles(r.Context())
 	if err != nil {
@@ -130,10 +146,17 @@ func (j *Jar) withDefaultRoles(r *http.Request) context.Context {
 }
 
 // WithAuth simply pulls out the session from the cookie and propagates it.
+// State-changing requests authenticated by a cookie must come from a trusted
+// origin, see csrf.go for details.
 func (j *Jar) WithAuth() func(next http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			ctx := j.withSession(r)
+			ctx, fromCookie := j.withSession(r)
+
+			if fromCookie && !isSafeMethod(r.Method) && !j.isTrustedOrigin(r) {
+				writeCrossOriginRejected(w)
+				return
+			}
 
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
@@ -0,0 +1,35 @@
+package session_cookie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBearerToken(t *testing.T) {
+	a := assert.New(t)
+
+	token, ok := bearerToken("Bearer sdpak_abc")
+	a.True(ok)
+	a.Equal("sdpak_abc", token)
+
+	token, ok = bearerToken("bearer sdpak_abc")
+	a.True(ok)
+	a.Equal("sdpak_abc", token)
+
+	token, ok = bearerToken("  BEARER   sdpak_abc  ")
+	a.True(ok)
+	a.Equal("sdpak_abc", token)
+
+	_, ok = bearerToken("")
+	a.False(ok)
+
+	_, ok = bearerToken("Bearer")
+	a.False(ok)
+
+	_, ok = bearerToken("Bearer   ")
+	a.False(ok)
+
+	_, ok = bearerToken("Basic dXNlcjpwYXNz")
+	a.False(ok)
+}
@@ -0,0 +1,75 @@
+package session_cookie
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
+	"github.com/Southclaws/storyden/app/transports/http/openapi"
+	"github.com/Southclaws/storyden/internal/config"
+)
+
+func TestIsTrustedOrigin(t *testing.T) {
+	web, _ := url.Parse("https://community.example.com")
+	api, _ := url.Parse("https://api.example.com")
+
+	allow, err := origin.NewAllowList(config.Config{
+		PublicWebAddress:   *web,
+		PublicAPIAddress:   *api,
+		CORSAllowedOrigins: "https://*.preview.example.com",
+	})
+	require.NoError(t, err)
+
+	j := &Jar{allow: allow}
+
+	tests := []struct {
+		name    string
+		origin  string
+		referer string
+		want    bool
+	}{
+		{"web origin", "https://community.example.com", "", true},
+		{"api origin", "https://api.example.com", "", true},
+		{"sibling subdomain", "https://evil.example.com", "", false},
+		{"different scheme", "http://community.example.com", "", false},
+		{"opaque origin", "null", "", false},
+		{"allowed wildcard origin", "https://pr-1.preview.example.com", "", true},
+		{"trusted referer", "", "https://community.example.com/t/hello", true},
+		{"untrusted referer", "", "https://evil.example.com/", false},
+		{"no origin or referer", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/api/threads", nil)
+			if tt.origin != "" {
+				r.Header.Set("Origin", tt.origin)
+			}
+			if tt.referer != "" {
+				r.Header.Set("Referer", tt.referer)
+			}
+
+			assert.Equal(t, tt.want, j.isTrustedOrigin(r))
+		})
+	}
+}
+
+func TestWriteCrossOriginRejected(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	writeCrossOriginRejected(w)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var body openapi.APIError
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, "cross-origin request rejected", body.Error)
+	assert.NotNil(t, body.Message)
+}
@@ -37,6 +37,20 @@ const (
 	AccountVerifiedStatusVerifiedEmail AccountVerifiedStatus = "verified_email"
 )
 
+// Defines values for AskerAbuseAction.
+const (
+	Block    AskerAbuseAction = "block"
+	Log      AskerAbuseAction = "log"
+	Throttle AskerAbuseAction = "throttle"
+)
+
+// Defines values for AskerAbuseRule.
+const (
+	AutomatedClient  AskerAbuseRule = "automated_client"
+	QuestionVolume   AskerAbuseRule = "question_volume"
+	RepeatedQuestion AskerAbuseRule = "repeated_question"
+)
+
 // Defines values for AttestationConveyancePreference.
 const (
 	AttestationConveyancePreferenceDirect     AttestationConveyancePreference = "direct"
@@ -50,6 +64,7 @@ const (
 	AccountContentPurged AuditEventType = "account_content_purged"
 	AccountSuspended     AuditEventType = "account_suspended"
 	AccountUnsuspended   AuditEventType = "account_unsuspended"
+	AskerAbuseDetected   AuditEventType = "asker_abuse_detected"
 	ThreadDeleted        AuditEventType = "thread_deleted"
 	ThreadReplyDeleted   AuditEventType = "thread_reply_deleted"
 )
@@ -329,6 +344,14 @@ type AccessKey struct {
 	// Name The name of the access key.
 	Name string `json:"name"`
 
+	// Scopes Restricts the access key to the MCP server. Tool calls are limited to
+	// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+	// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+	// resources requires the "mcp:resources:read" scope. A key with scopes
+	// cannot be used with the rest of the API. If omitted or empty, the key
+	// is not restricted.
+	Scopes *AccessKeyScopeList `json:"scopes,omitempty"`
+
 	// UpdatedAt The time the resource was updated.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -340,6 +363,14 @@ type AccessKeyInitialProps struct {
 
 	// Name The name of the access key.
 	Name string `json:"name"`
+
+	// Scopes Restricts the access key to the MCP server. Tool calls are limited to
+	// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+	// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+	// resources requires the "mcp:resources:read" scope. A key with scopes
+	// cannot be used with the rest of the API. If omitted or empty, the key
+	// is not restricted.
+	Scopes *AccessKeyScopeList `json:"scopes,omitempty"`
 }
 
 // AccessKeyIssued defines model for AccessKeyIssued.
@@ -363,6 +394,14 @@ type AccessKeyIssued struct {
 	// Name The name of the access key.
 	Name string `json:"name"`
 
+	// Scopes Restricts the access key to the MCP server. Tool calls are limited to
+	// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+	// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+	// resources requires the "mcp:resources:read" scope. A key with scopes
+	// cannot be used with the rest of the API. If omitted or empty, the key
+	// is not restricted.
+	Scopes *AccessKeyScopeList `json:"scopes,omitempty"`
+
 	// Secret The secret key used to authenticate with the API.
 	//
 	// Keys are prefixed with a kind identifier, "sdpak" refers to a
@@ -392,8 +431,27 @@ type AccessKeyProps struct {
 
 	// Name The name of the access key.
 	Name string `json:"name"`
+
+	// Scopes Restricts the access key to the MCP server. Tool calls are limited to
+	// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+	// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+	// resources requires the "mcp:resources:read" scope. A key with scopes
+	// cannot be used with the rest of the API. If omitted or empty, the key
+	// is not restricted.
+	Scopes *AccessKeyScopeList `json:"scopes,omitempty"`
 }
 
+// AccessKeyScope defines model for AccessKeyScope.
+type AccessKeyScope = string
+
+// AccessKeyScopeList Restricts the access key to the MCP server. Tool calls are limited to
+// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+// resources requires the "mcp:resources:read" scope. A key with scopes
+// cannot be used with the rest of the API. If omitted or empty, the key
+// is not restricted.
+type AccessKeyScopeList = []AccessKeyScope
+
 // AccessKeySecret defines model for AccessKeySecret.
 type AccessKeySecret struct {
 	// Secret The secret key used to authenticate with the API.
@@ -656,9 +714,16 @@ type AdminSettingsProps struct {
 
 // AdminSettingsServiceProps defines model for AdminSettingsServiceProps.
 type AdminSettingsServiceProps struct {
+	Mcp        *MCPServiceSettings        `json:"mcp,omitempty"`
 	Moderation *ModerationServiceSettings `json:"moderation,omitempty"`
 }
 
+// AskerAbuseAction defines model for AskerAbuseAction.
+type AskerAbuseAction string
+
+// AskerAbuseRule defines model for AskerAbuseRule.
+type AskerAbuseRule string
+
 // Asset defines model for Asset.
 type Asset struct {
 	Filename string  `json:"filename"`
@@ -726,6 +791,18 @@ type AuditEventAccountUnsuspended struct {
 	Type      AuditEventType `json:"type"`
 }
 
+// AuditEventAskerAbuseDetected A question sent to the Asker triggered an abuse detection rule. This is
+// only recorded the first time a rule is triggered by the same member or
+// guest IP address within the configured abuse detection window.
+type AuditEventAskerAbuseDetected struct {
+	Action AskerAbuseAction `json:"action"`
+
+	// ClientAddress The IP address of the client, only present for guests.
+	ClientAddress *string        `json:"client_address,omitempty"`
+	Rule          AskerAbuseRule `json:"rule"`
+	Type          AuditEventType `json:"type"`
+}
+
 // AuditEventList defines model for AuditEventList.
 type AuditEventList = []AuditEvent
 
@@ -1942,6 +2019,13 @@ type LinkSlug = string
 // LinkTitle defines model for LinkTitle.
 type LinkTitle = string
 
+// MCPServiceSettings defines model for MCPServiceSettings.
+type MCPServiceSettings struct {
+	// DisabledTools A list of MCP tool names which are hidden from and cannot be called
+	// by MCP clients. Connected clients are notified when this changes.
+	DisabledTools *[]string `json:"disabled_tools,omitempty"`
+}
+
 // Mark A polymorphic identifier which is either a raw ID, a slug or both values
 // combined and separated by a hyphen. This allows endpoints to respond to
 // varying forms of a resource's ID which may be present in different app
@@ -2433,6 +2517,14 @@ type OwnedAccessKey struct {
 	// Name The name of the access key.
 	Name string `json:"name"`
 
+	// Scopes Restricts the access key to the MCP server. Tool calls are limited to
+	// the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+	// "mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+	// resources requires the "mcp:resources:read" scope. A key with scopes
+	// cannot be used with the rest of the API. If omitted or empty, the key
+	// is not restricted.
+	Scopes *AccessKeyScopeList `json:"scopes,omitempty"`
+
 	// UpdatedAt The time the resource was updated.
 	UpdatedAt time.Time `json:"updatedAt"`
 }
@@ -4925,6 +5017,32 @@ func (t *AuditEvent) MergeAuditEventAccountContentPurged(v AuditEventAccountCont
 	return err
 }
 
+// AsAuditEventAskerAbuseDetected returns the union data inside the AuditEvent as a AuditEventAskerAbuseDetected
+func (t AuditEvent) AsAuditEventAskerAbuseDetected() (AuditEventAskerAbuseDetected, error) {
+	var body AuditEventAskerAbuseDetected
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAuditEventAskerAbuseDetected overwrites any union data inside the AuditEvent as the provided AuditEventAskerAbuseDetected
+func (t *AuditEvent) FromAuditEventAskerAbuseDetected(v AuditEventAskerAbuseDetected) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAuditEventAskerAbuseDetected performs a merge with any union data inside the AuditEvent, using the provided AuditEventAskerAbuseDetected
+func (t *AuditEvent) MergeAuditEventAskerAbuseDetected(v AuditEventAskerAbuseDetected) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t AuditEvent) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -5146,6 +5264,34 @@ func (t *AuditEventTypeProps) MergeAuditEventAccountContentPurged(v AuditEventAc
 	return err
 }
 
+// AsAuditEventAskerAbuseDetected returns the union data inside the AuditEventTypeProps as a AuditEventAskerAbuseDetected
+func (t AuditEventTypeProps) AsAuditEventAskerAbuseDetected() (AuditEventAskerAbuseDetected, error) {
+	var body AuditEventAskerAbuseDetected
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAuditEventAskerAbuseDetected overwrites any union data inside the AuditEventTypeProps as the provided AuditEventAskerAbuseDetected
+func (t *AuditEventTypeProps) FromAuditEventAskerAbuseDetected(v AuditEventAskerAbuseDetected) error {
+	v.Type = "asker_abuse_detected"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAuditEventAskerAbuseDetected performs a merge with any union data inside the AuditEventTypeProps, using the provided AuditEventAskerAbuseDetected
+func (t *AuditEventTypeProps) MergeAuditEventAskerAbuseDetected(v AuditEventAskerAbuseDetected) error {
+	v.Type = "asker_abuse_detected"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t AuditEventTypeProps) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"type"`
@@ -5166,6 +5312,8 @@ func (t AuditEventTypeProps) ValueByDiscriminator() (interface{}, error) {
 		return t.AsAuditEventAccountSuspended()
 	case "account_unsuspended":
 		return t.AsAuditEventAccountUnsuspended()
+	case "asker_abuse_detected":
+		return t.AsAuditEventAskerAbuseDetected()
 	case "thread_deleted":
 		return t.AsAuditEventThreadDeleted()
 	case "thread_reply_deleted":
@@ -38830,514 +38978,519 @@ func (sh *strictHandler) GetVersion(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9f3MbN7Io+lVweV+Vk3tJKXGye/em6tU7suQkOvEPHUnO1qmlSwZnQBKrGWACYEhz",
-	"U/7ur9ANYGY4mOGQouzYm38SiwM0GkCj0eifv48SmRdSMGH06IffR0tGU6bgn+c0WbLJuRRGycz+oJMl",
-	"y6n9l9kUbPTDSBvFxWL04cN49PyWLna1eUG1mbyUKZ9zljYbz6XKqRn9MLr+8fzbb59+Nxq3+n8Yjwqq",
-	"aM6Mw+8sSZjWv7DN5cWV/WB/S5lOFC8Ml2L0g2tB7tmGXF6cjMYjbn8tqFmOxiNBcwufQpu7e7a54+lo",
-	"PFLst5Iri59RJRvXcPx/FJuPfhj9z9NqxU7xqz69TJkwdl4KZnqWJLIU5mcq0ox1I2fbkCU0stix9zQv",
-	"Mpi0LM0yyehadyJt+95h34OxbqDZRvy/SqY2R8H+NwupB/0HottHAIBl3+4DJkff+suLIatXw6tjiQCx",
-	"wxDRmvWsjP3asy72865VaZ9wgPqK5kg67VFvl4wkGWfCTAolVzxlKZnzjBE7LJlLRcySERi8a2Fsc/jn",
-	"AEyuqFk+ZP61sfZahTLl5vmK9RGmbUKYbUMuLzpwsG3uoM0x6TMgd8tzdk3Fomuz6jganjOibGPi9yO2",
-	"OdCisTOBsXMt//bXb76dcGGYWtEswuEbyG0K9iPPDFNDsNsUzNKRYSqgx94XmUyZX6sYtrabbmDLDcv1",
-	"TjbUQHL0IUyEKkU3MI9zathCqs1NVi5ecG065uCbEZ2VC02M9JOYbU7IyzIzvMgY4UIbKhKmiZwTs+Sa",
-	"hHuQJFSQGZuKUrO00Z/kVGxIggNwpk/I5ZwIaYg/d2MifHMuFmTNswwg0aLIOEsJFSmhWUbMUjGaat+A",
-	"KGZKJVgKAM9e/TcixQJcsqJZyfRUcE3sETMSPrP3NDH4zfaYjkSZZdOR/SaIFNmGlMJjC3OpDTsVjXH/",
-	"brtUmFuuEe07BvylWTIVkPKz4AshlV0EGNoiiKglUhjKhYUbUPR9Eik0T5li6clUdByAasEHs+1tWmkR",
-	"UAcLeSP4bxZjT0Nvrl8AHXVwNN/uzrbZk6GdyyxjiR33Z6ovDcv77jbYHl2wBMS8MS4fF0lWpoxQMucs",
-	"SwkXsOiK6UIKbWk85Qk1QIlLZrdsKqQCgrXtAjhiTyixR0AxbY++A5QEDE/IrT0imq6YJhtZToVgLLWA",
-	"jSQ5vWfErCVwCc7gyCVLltwTPidUBOhcEFqH2bnfS6rvbKdDL+lqZV9Sdd+xos+5XZAfpmJC7AVauo0P",
-	"Xe01Zj+eEdwzfyQt7yXT8ptvvkt4Cv9nE/zT0gD+UM1si1wC9LucqvuDbx87LTdTYZgwL5hYmGV7js9k",
-	"uoHTZzc1g0Z2F2Ybw3SgaHycVEg6mBMHdABR29tnASDeTxZyUv361+8Bywtq6ELRYnlWmqWs7h6aZXL9",
-	"PC/M5lfLJzz85hxCZ6QjCiDwQnJcSzPjWA7cNK4JSy3DNks2FRWh5yyfMRXju0DfAJXosiikMpowIBHi",
-	"pMapuLzQRConnWvgkYFjIjUPuB8Ru44bcotLRC7BsByemT1oNQOf611PrflC4FW4tZ5J865tLevQReng",
-	"8IMkh/rh71uwX7hIH7RY91ykbqGGzcp22H8+YVR7J1iko9N6nlOenaWpYlp3C8SCMNuOUGxILi/sbsqE",
-	"U8NSsuZm6W6M30qm4aJwxN5x3wG0OwftiPIzCH57M2sv6Z9Ef4dr+9gcHB8Ox2Hel4kUN/xfrD1d+4Vo",
-	"/i/k0pUO4C/fPn3/l2+fxlHjiRR3tlMvZkyU+eiHf9RAfff0/Xf2/9/+7Zv33/7tG/uvp9+8//Yp/Ouv",
-	"/+f9t3/9P/Zff3n6/tu/PB29jb0wLsWKG2qR7yRFJ1jx0LL7mVi1OSKF1VHsE7R68dw639uIHoTYCy7u",
-	"dwukGRf35KZbELXfDxFCX8mUnS95liombqQyHVjYw4Uy5lfuUuSCIFx7I3JhXyoFU2bjfv3aXhZaKmNf",
-	"Xd2CvRv5zrYc7cZ0F3UJmbJuurJfj0hRFiH7tPgRHuMdiNkGBJ/r4yBPEKMYsyK5YoTRxN/F+ErSVkh2",
-	"60KA3xOppmKeUeO6hK94Pbt+VtK+vCBmSQ1RbM4Ug9etWTKu7NuWCdO9EU6hUF+JlM1pmRn7olfM8hTP",
-	"OdyfFqE4N7ALY0kV6GrAhvWQNWyZJes7mPQxt273mRuM3PHQsn8kgxipqLXtI/mq1VFJvwJ7Y6gpdYcu",
-	"pt6QaGjZxUzx62Au2kYhPPNf22fGFWpOVJyX8TAfkOKpINDpqVe4KKLLZEmoJtORWXNjmJqOmnex+zm+",
-	"7tJK+Xce2J48+YouuICJdaxq1cC9WCoTTtfqFnSxS7l7BTzCKbg7Rv5RKlIWmaTw9hdsTVZMaS4FqNGo",
-	"IOw9d3KkhmcEKKua2jUjpyIopC3L8rouGN/pqfE9lpfa2PcEsjYqUtBdUOJVyCdTAe3mjJpSMcI1AZ2d",
-	"3VPNTQlrpB3b3MiSrKkA5ZliRUYTAAzjTQW37NR2pwtUWLH3ZkxmpWWmwF4tilJxu/IZSs6UrOkGoTl2",
-	"S7iZCngWIkI6kBFLuaGzjJ0mShaF/RfhOV0weFCCst4tJFlybaTquTRxne5qxoTdu/pfIN5brjL48XM5",
-	"twstbdNJWZDfHIRxfa/8jz0yksPWtxyAsNRmF/MrpO4xM9ivR2R2V0raDdIgQbL02abrVLp2KDui+gGP",
-	"51e0YdfTXw97Pjo4d7PNAer0plUv8oD06P6n5KLPYBGm9U/JxQBrhW3G0geYK/yA1zLrN1YEzJTMDrFU",
-	"2G7H1zd4rKw0vZtWnIjcu6IDhONrRpOdp0bZRt3HBj4f8dxcs0KqAUjZVn1Y2e9HR6uhDGoihg2IoWrB",
-	"DCp9kLa6WFxLzRPbHwuzV1Ryw6IYtGPEPWWl+ugOHVzIG0ZVstxPKYZ9vKoUptiF5m97Cj72xHfSi/3Y",
-	"adW1R/mINPIR1qVvHW7p4hXNWbBxdj3K6bZ5s2M8QxfDiaU2eB2ZbhzAzaDj9Bq6uDvA1n8LZ8+/0joO",
-	"zOUcNdXwMoTHWqWAzuUq6Kv9SbYtgsG26jkVPV2VlD2vZgR8Z/vv2lEwnvYoOLGBV2BaSbdgKqcCrHGB",
-	"OLtWGTo/TCtZYVhDWF+CRfeKC8G62KU3GcCS2QFJAc1bNm5nHa5ZKcvMaJgrWBXwh9BcKrAKEzt3xbKN",
-	"s+aQ3Mp+iiV2ZawYvzkhzzbEaSvG9mnBtX0IuF3GtYxgRIuCUUUo2kKNLIJ6mCttpsK+mLq3Hidzh4Bj",
-	"mz+TMmNU4GIqxi5Y0ek2U19CaoVbbvjKGc9R3kYS3bbvNo3AWTYV9bNQFp6KK3tNarGozDy2wb+YkmP0",
-	"GOBzvxMgrnvQmlCvWfOWfYrHqWXwGaNnwJprNhXYVhaTjK1YRr6yh+nrrYPatBTFVhpQ3nG8fuWaz3jG",
-	"TRerRDkymELh+eZWJSGr0Nt5NJyQV9IwnOasTlswo6KcZVwvndlcE6pafhRPUkXn5oklw5rN3vaeCvik",
-	"iVyLYKGM2F8Aqlv/AFWxFWdrC7ZmgxvXIeC6zinP3GbGQKeS4fFY0hXDt7hgCdOaqg2wHa7hJWokseMR",
-	"LiY4Mk54sFGvWtf9hexqRyNC9gdkckybZzLlrOm3eq4YNWBTcbsNN3hRZE5ZdPpPbUni9+Hukc4fVnDD",
-	"aXalZGGFqJpXojfFHXPMALd72BtmzlbUUNUzrkwMMxNtFMNTEXmTzbigsGst1+BqqDdFeuQ1tVBflqAS",
-	"aUwtzbm4YcbSqz72qHXYsbG1ZuYNKLcea0W3ZUYczSm0Tkbgq2eWsO/Hm7aHGKMk/+2Kar2WKj3+qB7y",
-	"kNGvmWbm8VBA8Ftj/8oUn2+OPyjC3Z7uo6zzFeUqMsaxGWENdMdmPt4+NiB3DXtsflEDHWEXzxhNEFxt",
-	"NMPem9Mio3yPcRBQHbR37TnyDnqwkd3zny5Yxh5hRAQbG/DIe+bBRvarOeIVCNmt/Xv4yB5wDIPg/Xfs",
-	"jQ2AY1sbPh57rSsvy/ZcwaHnyNNE5//2DOH3K6oMT3hBjy6tbIPvmu1jDBsZq3JkOfLy1jxk2mv8gov7",
-	"I49nQUZGeilTpgDe2WOclW3wEQzAJ+a4o4LzSnykn5iwCLHzapyjDbkF+xofTZHBb+lCP8rIFnDPsNxk",
-	"7HHGtZDbAx/5jFqQkSNajXT0a8aC7rliaiOjP5Z7HR9lbAdyM2TczU0AOXjsQYqBJvwmKm1FwZavytG3",
-	"vwIdXZTtkV9SsXmU0V9w7e8fHLvhA3NOs2xGk/ujDQ3QA1Qc8WophT9x56AZOhbZbQGuLzF8uylnOX+E",
-	"MSu4jSGlNmBuPabGB+23WxfEtrbgLE0JRSuuU8+BstiA4sCidWTytiC3yXobJ7wnHSKgV4UoH9S0A2LX",
-	"rMiO/ZIBmLuWK6AGVoIxWS95siRc70BWKnN8bKWKCa744ci7hkAj7OhaZscWa8BgG5mXzI5901qQkTmh",
-	"merIs0KgkXnhhyPPzBne2nOrVOBHHrECbEe1AOrD/p3NLHcXL+k9O9PayhBHlF+uylnGE1TTg1mTZpFx",
-	"ax8fe2CwJaA9LWZHeP3LI1gStC5ZGmNZr38ZodIdG9pb/TEQsHCvwdrai4QshamLEcdHx4/wkpmlTPVO",
-	"bECziqfh+IjUI6p2YvJTh/EFnDpPC7F4sG3g9S+jcW/imNiUXPvTZuNaJpm+TtAmllGmr1Ozcd1o9BN7",
-	"BGr5IlfqsSi6h4rTnIvH4jOv14Kl+zGbumnuyHRTB90pK0bQOP6m7IOJ1ix6gP7X6f96MGe5BYP/GnIb",
-	"oNc+uvS7tDEnn+1pqgy4x9w27ayGey9jI/UKihNHRSzA3kVMoeGRj1aAO2TsY0sPDcA7GczDxJiioUzL",
-	"naZhl/HwpW33YTzyYUB6kMWxhuXIe7qgO98/apDGiEUVfydn/2TJjhW4KYEpH3UXAtQhN/MNM5NzKe85",
-	"689qB/ZVmnr9bTuvBU29Y9OoZS894vQ84O5lbVo4P8nQxz3UO8b9TK8GP6sjM6E62F0sqGl//riUEiy1",
-	"Z2n6SqZHHT3A/js3kLIiroyr8up4J0yapgy1bg38ruRxt+io+B2fwwTQu7BC+WELnyOf/b3XiguUP+2/",
-	"qUj92m1h+eAbt8qbpIfPIXqD1iENuTxrc8243p7YNcvliv2hTxSi+Ic+VMfniEMPVQkjIz5Vkip938IF",
-	"/Log90vU9XPnk8sFB7mYh+Z4L6lJluyYUlkTdPfF1IcVfnsMpBDyXljVXlBHxKjz6QIfHMetxj8ur90x",
-	"+IKZauQjSy0DXk2IROB4Nf+mj7cEeDhh/B+lmvE0ZSKakcB9+jAe/cTMpZjLI+JowXULVpfCMCVodsPU",
-	"iqnnSkl1vKfV1SUCjIzuxyU4MHEN285hR10JD7pvPXyb4x6W/cY+8nFpAt4l5r/g93Db7r8ATZEn4/ds",
-	"dyi6YbkdMCrqIIQhQs5ZlhFojclQKqcCmAyGqh93Qx1Qj3v3or4AtCASiwpS5VHQZMFXTDgsvW/iETG0",
-	"QK99Xo84ZuKecJGy9yz1WBx3kSzEzpFTamiY/ZEp3oPs2xZxX10Pr2TNeXE7AZAX/UbOTewsTSEx1BHx",
-	"fQWKtjaW9ncXHoxyJ7mGOD3t85dASPCo4fL50dCqvefsDwcpkJosI4U4P+rt9QNQG8AbANkUkKuQ3fIr",
-	"PfKatbxWu6gQF9K9LBauVxvLW7rQj4Qiurf24mfoQvchx03GHgs7dILtR8+2ieJ37G21T0WfarATnU6F",
-	"wmeqePRJAo+8lv3cGVayxp1ThlqAT8B3FQy8g/Me/WUxmNyCAuAzJq9tf+8H3SHNv4Y4YncZqjyYt0Mv",
-	"mapPQy/T5Vr+kaeJgx5tsiGHp0ui2pyx+VGWIo2mUyRz+ITNLvMiYzkThnU05rUG2KVObO32uf/62Z6H",
-	"pk/8UXlKE/Suh2Dc+/8PhdAjIdONgn0Yv5DJI2gI6pBj49vvJHMNiGJGcbZiKdFovJ6XWbYJfvTevf+I",
-	"+AHITsSCT3+lHq/8+Y+8Sp1IOBYUWRJ8rP8IuSCZOrKDFjrmbo+xi5gb7blYPDpOXCwG4vSIqHxZRvmg",
-	"BNKPtmBD+GItQOWoB77INnG3Mcj0BUEpXgvQPnP1QJTjYiVV/1pIdWx7QwV0wFaEgJiPOesQGXPMQWXn",
-	"eXVDHpdR7B7v2Nsqh52vW3pk7gzsp2e0I8/TQdw5zVoo0jFHB7A9jKSuSMSffjpi8pW+4be0NTNZmhBN",
-	"B8obbjTYEvRn+77G6R+boALQPgW7NuBVXBUL/MwX8ehcfefJqL+p3wisu8V17Okbvv4L38k+Fu0nZkII",
-	"3DH9U0IImvNwfV2gR9KRXWj9NHzwdBj2iHPxY9Tj6wDOo8zpg08kiLF63kTeLnxFan/70gS2qcupCOkQ",
-	"ybLMqX0M0hQS8udMQ/Z/KNIpNlOhWAbSWc4MTamhZK5k3ki3CE2rglqaqRVPmEuR2FQysTimyEadOR/a",
-	"jCE3o/1NpK6WARPppNRMkZTrIqOQ6LdVKs6hH1sMmOikNdFDxsCVAJpJU0hDgTGyfqKx1MhnYkOq1tVy",
-	"+vX11Uzt7GvDehXaeKTLxYLpqJbrjISPxD2ifcVjO5uTaIr5uvYO9+VtZNQQwuRyQL+ej374xy7PszzH",
-	"cFq3Hh/GA2MyXQxHLx6NkOSWFpO9L7hi+o6ajgyzUHSjKt7u2o8JnxNRZtmYcEMEWzHlP9nFC/FFlpdO",
-	"DIdczi26wIyfMdqGGtQus241+O5tAYj9q4FhtIP3ptrOwZtywxLFDOxKq7BebSU5YAIJGYKPwpj4RMSQ",
-	"U7jMsnoPnM5UVNwI8vTCcK72CdeYbJe9L6Rm9jbzXsCOpdkeFhYVKSZxxe6u5i7Xbi+1kQoLXDKS0Cxj",
-	"yteIShhfgXOFHcojpH16YW45BRRSYUkJCZgtpCaqbizbyp5kZY8c8r7ubQMV+h5lLMKebSV32QLpRKnW",
-	"qbhnG71XYHSLEgFCLyV2HUhhuW0aSwo9/hJP6zjMuHe13KFqLZcOv7fxcuRmF8IX5bYSm5VmEmpYVcny",
-	"7OryZCqm4he2wczMhWJz/t4Xu6RYz6HKqD4m05FOC3o/HWEZH415Vabixki1SZkgV0xpuLdwBuQXPHPQ",
-	"cdbq6LtNxTNpal3wAJq1BAwQN3/Pq2RJxYLB3byUa9hUs2SbqUhlSNRMZmxJV1yWimYk5fNQ4Q1eWprk",
-	"DA4pJSuuS5qRpGQ+U7MvWQUTvaPfzp4m36XfJ/Pkm2/S75/+3xn92/ffzv/v90//kvz16fxvT7/7/tvv",
-	"/vbtbOemuw3r2GwIQH7Ui9OOUPXrvjybWQaiVVJrxGS5aw4tIVeMAKliVRWMd9Jks8dUhMJh2/VVqyvh",
-	"hLzRDNmtkV7MIhTklCfajTMVUVw00SAkbaA4PUu5IVI56zrhJiZwOsVAH4fhWCzZz3dNLfdfcG0g874T",
-	"y2oVYYexF57uEHNdlQOoVgiUC6MvqT6Jgwu1OaJg2XsHtlbU7Suz5ColBVVmA2nrFUmZFc3J5cXX+7HE",
-	"wh9/4I3gdehXBhGPIl3Uys8NDflsHTAoaFLbxrHns7UlqQ01iPz3vX63Dk/8Gt7O49Hi7Ujbew+H9/F4",
-	"RFeUZ5Y9PjiC1iFSB9mzbM+4jBOF4slyYth7Q2Zc+hKC7qA80VgiICEFGiGadQOx0vBMphtXaRj+LvCP",
-	"JR+TfIOkxjV+Oi0iDbUszTLJ6Dra6LQCHyPOCO9s71iaYxLjtugyw1UZsJN2/aysU68azfQB+Vg8IWAB",
-	"tb2Ln9UqqQ1zTK55/o59RbNdiUWhtPx/QtsLSMQ3hqq8euCQzx0b8863/rm9e1z3JK9xsQGL88o2tV1q",
-	"hnu9j5X/HDOMjF0ZtWGjepsBPup1AeqHYSt745v7xV0xBUrGO1cNaxgGv7petWpYdf4Qqtc5Sgss1xeL",
-	"s8TvN9ZtUBuVNsmP3YF62y4bAfmG2o+HOoCdkTSN1EX+Bh5exM7jHymyhO/XZhV539zegzPWLGXimOD/",
-	"V/GdeiWc1u3WnGYNkx6u3GIMsdJQZlkT2ThUSZ7zRenkGitUl5oRKjZubqFiJzBzKxRJNRVGUaFRrUSz",
-	"U+9qnMg8L4U/NO6lj0WFsjXdaLsoLC+ML+K5x1W7vZMdl227oMMxCWhbQ9aA1LMxPwfu3L4xncz3H64G",
-	"ppeiK9myuiFvwt3WurzGo/eThZx03WiNLHqtFdn73jr4trHyszZ6r1Jrn8Ft8aF76191ys8+ZsdyCaXD",
-	"s8cXjau2/RlVgs425BfGRJ/YAobuwQ9LNIuPB99Ou5+S4Q7bU4p2mHQd6WrwNuHSNKbXfy0Ylj7N6cay",
-	"nJRpvhDw8qSaUALdgjY8PEItcywVG0OxYr2UZZZCb9wYllqxNed2CtmGSFREOUnW1fqHGl++SLJuKPxq",
-	"YmIoIB+hCsVAAWLWksxKnpkJFzAV/QNhK6Y2UjgzjL00HYN1oMk8owtQVGpmsMoVdyVgQWUa9Fdu/K0B",
-	"4thucTxc8GoKPdSwJU+A2g/r5AsJZBxkA2CjkYr5W3nH+nkYTRImzF0iM1mqiI1sPGqqD+72TVZUMwru",
-	"8iQ8rwK7Ghv8e7/daCh7+q3kyf2dd43UMdNP5qzkLJf/5CRZUkUTY7mMXsq1sKcAgFT+lRKNkr6S3pvL",
-	"k6l4LbJNJdKU2vYP+sTz6+dnt8/vrp+fnd9evn5VL4+WUPDXDMD11m3fWoTtg++thXsljrvBTlXGc59C",
-	"v62ra5NsOwNda1GD2hOEpyyrQnHgLHJtlKvm7+CctBRPj0+jtKCQwHaA//6lkwHPfZ+Nvy7/pPQvhtLr",
-	"vBubNTeq2uzxFnXGabG9JW93HacGttGUcWpQZGZVn8RB9AN0HGmtY7YUe1l78a61O0vGF0tT+yRK+8Ae",
-	"9nCEAS8vgNR5zu4QRGQUDPQamF1xjBVtowLk2dUlsV+DXUpj8V4Jzmc6FDkFiE80+en5LXl3Cq30u8Z1",
-	"XyG35ikOt7UCsSdqWMuxL7tbTdxDCov6tmuPLi9ix9q9imqaaxTX0AwrS5VsCclJ8pdMpE/1t/r7v/7l",
-	"KU1N+Zdv6or594DywEcT4qWHC7LV3reEWPtpP6nY73wU1A3MfX+A2O/N9YsdkG2LqCHINiG48pDZcymz",
-	"FHUgXvuBL1c5n0+KjBq78iRnKaeubygzAIY7CY4p9gYlzZtVJOyEXBqQ3RUrFNOQHqk+tFMrBy+dVK4F",
-	"1It06ubmcGjnJyzTbG0F7KhZ4swYpl2CEClWbGPxuFJB29lakqUxhf7h9HS9Xp+svzuRanF6e326ZjPL",
-	"NsXk6en/tOLuhFZwJwkARtOjE4VTruxZsD8YpgrFNVgxRPgdZOWoaFzlGB3u6LGdGHU8tP3tpuh9AIaG",
-	"QYMOt8pVqRaoPGsJQrbV3b7qOF/3d99aVoBHEzU7Iy/weFY9fC3alysyvdrEBq3TTV3Ve5w1+nSTeSP0",
-	"FzGd/ZhrLddvhLHGkvEOPq9XdMFBbeHdocctXaYFrfdLCRyTl95uKTYRbP8ydTv3JIeblPYmD55b/poX",
-	"jZTfvVb4I1BUJUvUMXjbWB70tcbsJZHzgL7rn/A4VAj0bzPOA6K2OicDMVufcC5h/B1TcQP629ctgUvi",
-	"Uq0Jgqt+9pyjYm/Vb6WI/epeVXcFXoD9F3h1uVopg1spI7fnHr2ic1oUHMtmdEDfuXTRSzk2raGQbnYs",
-	"xFA4bxqLt7UduymiccI6dm8glAZ9fwiKmw2q73H3PoxHUrC95KwminvIWzGkhnZubdLeXev7snfnJpl1",
-	"yIux0utxS92+Jt6DbFMxw96oF/Noxe9POAP7Bq9KqO/w7Aesaj0GzTQULz/CFI28Z+KuVFkb3m8lU5u4",
-	"wgM+kYIqmjPjHEvhdedCT+yzDyATLipncToVcwU0mZIk4/Y1qQuW8DlP0E27QxXisGujYZ+gRrqAGeYV",
-	"iX4xHR6wLA6JN9cvnmh48k5FXmr7tjUJKhdr1vfWM/iJJms2q5wLOnHd2l6L+NitYyTvSpwWqh3pJYZ6",
-	"9f5INmpUV1damf/z9G9/+evT2OoeQDYdmCfxeg+I9EuPkrvyg/dKOAPL7he2WV5RrtrzbDpeVrOVKY9S",
-	"Eqxts2k4ers2s+HRiIC65jqMJdXZRBufb59+txOlnWzDI9JvsRNsHcfh+7/8NbaKMnsAzrbzGIbchTSw",
-	"uSOhHDZ+h2Yemu1Ar+Y3u50QVdzHGdVyUzBlP1t2pex1rnbFgPU5/G4Fy9VDIryr7U6X30gEQ1YuhsLq",
-	"qPrindF2rd2eD/u6A3LsaV9VeIlwiN27zrsPUGV2ef7eMKG5FPocrq5LUZRG7xdluFtVmfLEpGw+aZp8",
-	"WBgbr00OY3dEMVU9pTozhibLPJr3dJjedAsZqWgA2dCfekUzuINLrYPmuZOjB4jXrrjlISg2UPNVMiOR",
-	"BjXt72tcqh1GX6kunIm01Qr3wH7+z5vXr6JN0MulVHG7E7jsFVKZpl1jp43ScorKga2fpreQfLuLUm5Y",
-	"qB/CDVOcHrIbEeqVSnvIiYMc255uot3FGWLdqrW4ZhrubRci23YBUs0G/TlaQtNrhO4HsxuDXjbJIAvq",
-	"m632DXDb4QAdc2yiHtvfZ4wmNef5bcPeDD5jtemML5ZmDfZBEkwtLloUAWJQG9xZiib3XCymoihVITXT",
-	"YCVKpDCUCxcSCpGfXGCSjcsLf6MgrOpFkEttss1UtIBDyDuxJ5Zp7IwJJsiz0nhnstApl4pBSN0lcc5i",
-	"SUatdIxx6hAHIxXNsg2BmHjLq+29CQjKOZmOwpxGsTClzmihbZuon2AjbNyBjl7I94NLUlwalv/CRdqO",
-	"/YRgmzYBdJlUQy2mxwt8C8XO6pFvA/uchcs0rrCItGsL1uAP44L7HAQuDFtE7OdV277ReuNQfKLMfUpx",
-	"oXdPp/dRIldM3UGl3sFG6iFuP8f2vfVT8qEawzwqmspnK3YOHefGtrV9pBqyuc4nAkZou9s47xqANa52",
-	"sY8OUAXY6UGzYndG7jP7LXw9hD4U+t+Uw2jqDgzze5sJ/n0oLE5HUQLq26u9njlVkby25Bep4tfeemwz",
-	"wOGwyYi2BccKTN/U+jUKB5DhsLvoVZlBSGR9g1upLzCvD80IjEVgLOeLErmy3YQhhYBw4PH59gch+YPI",
-	"t3Pj4mEQZ2EZnmjQSEzmNLFymA+C6JQjrqSGi3ibILbSt1aq4jlEhReuG6Y58oN7Fe6SM0VVstycEEzK",
-	"ZX+dCpeZvdS21zv8693YypinDaCE5lIsiOazjIuF9h1mbC4VezcVUpF3dG6YendC3sC3mTTL0ACEVtfA",
-	"u0lRyPqaxsRDaLgfR8KB9uszjPPFDkgfOVzXHas+pjzYx1xuHMX30Oib6xcTTeeoteolUAssHoNX+SwH",
-	"+rPkDv7ie7FsL5a02HZV5e8RV7cq/bmPvF0vaFpTX+lYKiGS1Opp2vfiQsmyqL3LqgBLzBUBL0I4Mto5",
-	"cRs5FUmp3FHmyvaA5YfnnQ9bDNnLNDfshFRIavDwtk/LqXAvTaKkNCRjK5ZhCkfylcPma+cyzk3mko9Y",
-	"IgEjldPBdmQA6l6U1g23pPrut5IpztI7Sytx7YL9cpcMfIrUGo/b8N/24rv1QNnev8abHq1dgTLH7Ro9",
-	"9StvGBFd1DoNveZCZ3/RQfzdIb5Kg27IMFyfiOeeCojJriUvY2rVn+Wa5FRsakusoRoYJtsyLCczxlwe",
-	"dWJkLQw5EEZ9mIsdEkjVsv9l8Om29Vi7078dl+4QPjqXtQPVRLqWwcHhMVirE+UDo7cf3ramt99zorky",
-	"vbcTTimfMaWXvNh2zhJS5TSzh6OcuXibO8VWnK2bv9EkYYXpcLLqWL9IUo+0IyEQJKfiOcPccBA8bw/T",
-	"mupwlrZY2/B8QHmY/N0QX7jelXsII1MsYysqEnankwEC4rVvfgOtW6ZWQGNcrWl7ov1n6kCC6ye2/pfj",
-	"Z8emepbvVVd40xaYyIVdyGyTS1UseVJ/s4ZQCsYhwJkSRdfk8mJMKJpvpcKnDLioaCsr5TNuRTOQglhB",
-	"oYQaCmrLTbFk3j3HCWtMpIXkwmg0VOtCihRktxVVG/tQwoAmObdDu/CfJ5pcXjjUnGreB4twEfLAGUKL",
-	"AoU3CMkmP0pFnP0+oF/X7HNBKHj4zErjpok56eTcMDEVPuskhYpdIMafXV363JBMu0jwhCmQFv3Mal5L",
-	"OPWpsPvjF2CesfccozBtb0g3y94XVhCz4hPVZM2yjFDtc/kRXao5TdhUrJc8Y4QJXUIOyoIpYD62W4o/",
-	"WZY3oxr9p7iTTTFU3Z4B6iMXp6KxOJjRK+QtD/GQlxfkXSzaCh+w8GKGVX1nZDH59ptJLlec6QmCeTeu",
-	"/JwgMUgpUqa0sV2BhCApqN3tH6YiOswkCtYuewdWUk1FHBe/ni31DHB62wRW5SVV944GIO/oCvN5pj4H",
-	"ACwPBOIhvA20pSRliq8o5MizW+B3XKQhx6ELTXLqh7BPVE+4HhPcWaC/8JigYHOyl9JaccNwWLMpeAKG",
-	"JqRO7RtraAVWJ7SIwW88z5EZbqdBHLzcW4F1E59LcnLPZnQ2SahmkxBjNyzmrsacQsKA9tvH3bK7I4B+",
-	"pvo8tIXIobuaZDyc4bpkTtuyUhPaeAu3/uvt79yABKY/yeu8LTbuKdNF1bcI5237EX/rc/zWitgBG6/W",
-	"b+x0c5YRoF5Oc7HI6iLVVGiZY/Qewf9uZInR1/O5VCCE6aVcu6oAKKOFEO+aaAYEH0E8umFba95WN2MC",
-	"wrN+qZGFGwuExlCVYqiQ6BzZ9xtFy7mZhOK0++WnHK4bzLlOImKEmnGjqLLcyCgKbM1zunCJ1IN4W0vv",
-	"6hPsN+Vaxcohs+3JKHlmRdYKhyhxdBUqOMB9RSdGTJIA0GXQd2kKJsEJK6ICLnxpgWGln7AGQVeBhS0D",
-	"dQAdm37zJbkjakWAC+6AJ+krzDBQSCv+D2gPRdtgTaAE2KAurq0LGRrUBwtW+fCSQV18BY5WHMk9xxKV",
-	"w+JI2rPdxffjWOzRx1Xn2qcLFrvdp0co2raTtsD3pKYUKHDLgxSi3N4IJJ1t/aLbawhnjOoHGoPt9e7c",
-	"Uqa0n57tNWqnYHez29MVx057PriQecNrx/bH7juXHujto6LsijA+AGXPCT4q1lt1CA9HH8/eR0XeF+M7",
-	"HGnHZD4q1qHA0WFov6QmWe7UAT1YOjp4BTrz03hd0QBRxq2FMyx0KrKba3IYA8Tl7OOA0KLLgeSAwfqe",
-	"IH2TvGaJzHMm0iqp73YccyJzJsywpL/ty2Mbpy14b+vI3DCq6qtyrJQA+99eey1nbIG3E/ZuqxVXNONp",
-	"M1VuM3nPkmWZ/A/tFENWSI49T/bMdrL3oxngB8X4MIN2PZtKrBgOiB5VHhsNiXW9BzB8HBNdJqA6QlMz",
-	"Fy6b5AQT7E/FgpolPI3H8G4WDkH711qqe72UBfybzbigakyYSU4IIOaS7zrT9VTYdxhVWJ2LiZSE3AXw",
-	"S043WFCDVgWhK1Whz98GKrHnNFm6udFMS7JgRkNVQ7kWXmFoX/X2XVBirjQLqcioEFwsgis2FHWQOTVO",
-	"f+XLvkLdrRVTGyLY2g+E5TwyLu5rpbHspw67OizBOS1owk1HRGlO3/O8zAmmuQLNhIGkQlD+hxrUMcBP",
-	"teGitlMYbctsWlH4f0pQ62LqIiLA5R08EFLYV8xQClOcMab0/+ik/x2OmLXZ7iTbsDTHyvm3c8Qti4mn",
-	"skF9qyLmj+P/BoPU/D0NT3iBqe8KmfFk2Jpe1TteYT94BvKcqs2efrC1tGJDzESAQHAKwmQI3sXo7pDE",
-	"KneKisWwhbvlObuG1h/GoxXXzpixq++vVcsO14gqP2ENo44NaowcXYK3XWxiL9GneVHERJ9PnvKnme1n",
-	"UG6ftwHv2rHsuNDC/WD544x5w2Cx3GjLye0FtuLKlDQ7IWfVz77bVFR3jajyxymSSKlSWABtOzoY1XD1",
-	"K4qLe2T8fconP/Qg1nLlG1tCgpEHdfvVtW2rezzed/vlD4kjNUgUaeHUTfHb8GP24O2N86n3tiUXsmKi",
-	"BImkoOoeDKtGMWamwm2uk0rg2o/tpj3tYxIa24uwTgtTcQZGWdsDBI4Zc+4XeKH+JOUC8n0XKCDAaDGn",
-	"2UpIbV2vGTXclCmL5v9s7uQ+95X3zsikWHTD73zzuSwU/U++JnY97702ZnXlWpv833aJIdt0FpP6tw9v",
-	"F+28uX4xhvJsKZM1+XZqZWGgpQuuE6mwiixTu0jpzfWL2NY/fAc/5h7tCHT4U8z7U8xbfDIxLU6y3u+o",
-	"evT8qHgKrjVM6bF76wBrd8+dJU3u8S3U+dwJCy0iqqOi0vfu7fImM7bfTld1KoaVVWrTSUdlpcpOAUgF",
-	"+J28oYbSrgiD8JodQ/ohVxMTin7pBj8eHHzQ2pUu6bfWph2kEwpg4D6MPJ7V7H8YOUNoIx+b19N92t3b",
-	"uS2+Eou/WWvTs9vQfa3G+EoNjiwgT0ySSQ0Z73An76TINgNhtqtxVMscysGNxg5jdNhJWZJB8a/uIeLX",
-	"lAm2gQO0+a5z5yn4GCFEUY1gJFAl54Ln9tlTS1oAnoxzplw+A3w3rblZytK4HDfADrOMOLXaaOdUjy0O",
-	"fPkX+9Cn8jZPfWzhYHB8/echEQwNf4/rcOwmDVPpBIrrZAvetbmSQuYghUxACpmgEDJBAWRiBZBJvwBS",
-	"rU/kmgVPJJjO1uOmckvWBRUkLzPDi4yRlG5AzwGKd3tBp3QTe6wwNB0Oc9sCnf7Q5tulqqHvGAaMrWnD",
-	"jzKWzsXVnuIihawyYoGVp6ryZlz4glgQjxS8JKvIpK46WZc99Y0/cYWISzGPFMB9RjVPfI1bLhAy2D5m",
-	"lunbVYlWEfqzUtCDKwVJMZNU2bM9sOrp69DBC3b/BuWGPmqloAaJxXZoWDGhNvXVpdcFE3eU2+uG5Sl7",
-	"HwqnYiIy+3uu/R8x8bWDtodaAiLIRZb90orV9JEDsqtBekLdq0b9dsScae2klAHl0yqoey5eWJbeRXsc",
-	"OwoP8PdANO4qUYM0zGFie6/iruXyoGC+3q1rpFxxY0QRBLeQ+z3eVrZ1V5TBgYGJ0bjCt7H3V8bvGdQS",
-	"EiBQjKu0cJAdzXaE2J2ot7mf63606xcoQrn2944w7TOiuRVHiCv9OgfUURXjY9RchENRZpkVp4yPoICr",
-	"Zy3LLJ2KGSNyxdQ9zzIMaSs1LIB/iEKMahV+77BuCFo11wWL8EU0LtZit/MBb7tXlyhMaEiXeGgNdh+7",
-	"kWO0WVFaV0SGC+R9jKCHnrABO2oXvjc+rjYSzSANzWoOKEgQiiWMr3zMJMbPnnRuXqXVebB8Duu+WzZ/",
-	"4ZIOP9JlZsHv6Ylluwxr2ekPGGMt9QzsIKP5pCF1+d7bskBwAm8eD2NcWSLbKdqxFF/trSxzykUHEYn7",
-	"TuciS0avCybIT3ZWpFDSyERmhEHCSfQ5s/Mo6IJhafpE5oxQouwr1SmtIPBVy4TTjMDqRNPbAB6IZgOF",
-	"BTfLcnaSyLyr19HyRGwvRV2u3dXvFhpWJrvedKnXL6Kp9bu253HElEG1zxvHJSqjIJi400d1ctoMxMXT",
-	"+Re184pD7wvgF5BVJNw0KVReeInx1BlVCxa1wiPdD1GB+ZemkOnuW+WVTENNN8jNM+Rh2r9u4YgiPI9I",
-	"PdQE/XobW/CROeMhGmncQa+P1qjsJ0ZKkltm1qOSbhPbUKGpuUZRyak1uSNzijTwrp0dseWH8WhOVzyR",
-	"Yk/F7eOpey12lbb3I3K+oRdVWweL18MkkflEy9Isk4yu9cQ7fHddGbd+cp1X3ZW76mIQXlJ1/2eSiz+T",
-	"XPyZ5OLPJBd/kCQXmLPpP6VlGxfUsEdNHICDhSpwH2G8Sm0/vDhJlS3Aq/1DitzeHAHbJX7PAd16od8Y",
-	"76/KzROnnzeSQBnF8K7zISjAx0OhMPdajkqzB1a2pSFFZ3DAtIjcOXhRdbWrOXbU+sfbEm81nYBjNfDb",
-	"AVux/dLr9c9uTHngdCJ73fa9piEp2DCn6yGDDJl9x1q3645qF7+O5hEwgnjFV9dDIwS13824jBLIPjs/",
-	"VGwfOsOIQF91vWFqxRN2w4zhYqG7asjOZLq5y5hYmOVdTt/3R2257MhE838x8hUXZLYxTH/tcz1nGzKT",
-	"KWf6hFyB85u986xwkzCv4oKecEXPLL/5J1qmZxtXvSMwC43YdylQXaTJ0ZB30shHwn4tVXo3y2Ryf5ft",
-	"8CeEVvYPlhLbzekDcGyXD9e/KRUrpLKbva+VEvDB3ociBIvSDC1EgCAiLHnKpoKKjcMYI/1cfl+z9FrP",
-	"oRjHTGI+4cMjaQEs+O281ttLZBkI5k0G5W4qkzL3PmjE151ASQ2UHJA9GVzsNUajTgWdaaPcRWnpEhIw",
-	"W2lbG1UmBspWwpWNE3cleaioAl6nwiwhGbpXkc4UFakek5yKck4BhtJju1dLaf+RcsUSA/8EN387U/vY",
-	"wjijhqIpXNlFcG1FwTTTEoMBqpzPrmmHSmN7OTsOLhetNFZ2kU+OoeB6dM98O8ctZYg9B3dACXdGMbaf",
-	"/SBQEOTjhnz1KSMWDkj+S56m9im5XjKBhVsbxizbrirIVGo2LzMgMbj2GidyKiiqEgnNvdWsQb6phHeG",
-	"YKjjAjKxDy7/0LVjTcWKszX5qoo60TxlM6qIoCu+AD75tUWI6drULNVpgwx2KigU+2MpWXEKM4EZO5yr",
-	"Tj89v609OZvJzbrMKb6G417as8fworRU8uDE2ANrBjhXpMMUZQ/MWTtM02ZRDJo2uth5om/pYkud/Cg+",
-	"lUEp3XTQ8Yl3t4+1w33LlRKo520HM9yV/tu2+YkJS+TMsSOXUCyeBx4+4RXieqVV9n0MibeMlOxoOxWp",
-	"ZFgZo9T4ZmXvuQa25MHZpx2eyFlpiKH3DPUfSakUgEBvoCc69IB6b+QrqAJABZmOWMoNyE/TEd6dM/ke",
-	"UxKgFuFry3amQjPh5Q0uiFQpqtY91qSQBnOthZGwIggV5MWLl7GnZO0S2OG74Rp27V9rb7xZqn2tKfjm",
-	"kzIinm4K9toP++FWx2L++Hjf0oXem6AslQ+iJtvwcyUlmORHpyPcj2FEZOhibwIayFztzRRVWkD/nZPg",
-	"xl5Ug6iK1snF9ushrFrbqcDGnxNt0Tp1AfYfn7xwZwbSF+C4N4Xt4/rahW+/D4OP99QDAz7BXQo7Oev6",
-	"oI430PYP9m5oi7SPLZ0OFzK9BPfg6Nzmdu+QikEX+bKs+40+mtBZ8cXh9t1jSqZd52UvNaN/D2yrgzyg",
-	"4/vWDHYqubVU3po59I671NhO/aXpXknDfiCVygcezYoVGU3YhGZZw4SWM7Xw2ZP9TdLpWPMnB/rCOFCs",
-	"uN7nxYyCARG9SJr1LsdDQgzCune9Ro9SEBJVpq1ikP8tS3CfSJYQ6gfWf9v0CbhHDKsNyY0rD8mNDiUi",
-	"pwI7SsGInP8QSkGOfR3IMZj8uUjZ+1A0MgQTKgbCHBZFrxhJrHRkMH//HopAdsXDeaoepd989y39Wyqf",
-	"puY3Q5fs/4rsmzbhhTKUzYV+KUH96tWC0MqV2IOpe08LrsnlRdTTtCpW2QsZm+0Hujq4HRVcBVv7nYVB",
-	"oNojuWHGCs4C9JeS5BYRVHpiLkIlpVMwH0jgXWV5GlUngXAx+DHbeK+ODRavd0w8Oulwj+1zH/+dm+W5",
-	"L1HdcTc32gwvqbtX1vCWp/Y4Wg79ztuA7xDCUM54A3+HC602maOt1P7sOvrQrYEZd8y5XlR8j4Icjvcl",
-	"WRnyEgAc1Mi3Xdh7K5e/kvaiqrID9IVpPJpDClsNup4rTDHB7AGpng+ovjce4dQOKjw5KMK1PrOO1DPb",
-	"4St+zXpz0NThnncVGW02C5l723vdyIXrvNIUXyzAfINGlgrOyVTgwic081z3XaMBjPSOMFHmXnuzKbwP",
-	"WMP94s7XDkDHAyPDD4XU5i7j9wwozV6jNceL3A4P6nbA+G5pG0MqulDn7i4kT7nzy+k++Ewq4XefGOZO",
-	"MXuduJIGUpk7qHBoTP0nV5Ik6vdRX+09X121kxrl8E3Aj/EKq0bYC90og2xCGxaOuQ0Ui3+3+dbBmDYl",
-	"7z0xbuLXnxjuQZxh57j7RTDXe0PNq4sBbg0dE3WP6o4VPYTWw3x20Hw7YVIpQvERuvswYv+D0aziKvuQ",
-	"dMvbzq3/wNjGKDG2X6MfLzvHDsF6PHp9VprlOc2yGU3uI5KHTDtKKxh34nemSzGYlbjDF7KVVqK1NhdM",
-	"QWAnaKtdbSxq2Ni7WDBN1vYW1IYuwmO0Sp5g5baEaT0VnelE7AuQC5d/XbEE7A5zrrQBUYloZsqCaMMK",
-	"3bwY3Uz1HTQOXpnj6oPPpVz/LZcqeHDq+geE4kr2WNrLmGHRA/N6LVh6Bu4VrprVI/lNhTG6QtW9MDTb",
-	"PDhevQbqbbQ2AFavR68Scs826Kxl/wFiUIiuo5nlNPazLtHFhQrvkDyeCm6cC00a3JXB4Q1MU2nOBddG",
-	"USMVOM2BumEO4n01sgY/HcUIN080Ecz+TtXGDuUcfxshw+id4/yk7Yd7tunwrGru7F5scIsoIiywDbyr",
-	"yIid437jRa9qABM79jUpp8jCNI8lIXkv4SHFfDrqkCCAuLJ6G4G2nA5OVTCi9mrowneqHmkhPCjiIIBW",
-	"zbuimZmi9lwQ7H3fZ/vlTvN/dXxG+6COf4QIe4AdbbD9wg4jVWCbMMbN6UTpISTtqUsOLrXP1eub29F4",
-	"dP387OLu6s2zF5c3Pz+/uLv92f5wMxqPtjIAjcajl2evzn7CjjfVn+dnt89/en19+bzW6fLVr5e3Z67b",
-	"1ggvLp9dn13/dwWg+uHmzbOXl7f+h7tXry+ej8ajN1cvXp9d3J3d3Dy/rXo9//X5K0DjxeXN7d3V9esf",
-	"L18ACjgc/l1hdP76xYvnfiLQpfol9Go08tNrNKv+ukNkLX43z++unl/fvH519uLu7Pz8+c3N3S/P/7u2",
-	"RDfPb28vX/1U/+XNzdXzVzcOqvvx+jXOwP/5/Or1NUzx18vnf7eQX7/BKZ9dvLx8dXlze312+/o6epVV",
-	"O78Xs6sRTITRXS2l8J4L5zJlPV6qhW3q00l4y3hBN5mkaftc8h4hzkJLmbbnAmL1BM1B1QmBw+7xXR+t",
-	"Kc9VYZ5RDaztd+fSn++eBwRMQkIMJw2h0ock4IApTgaU9wzz3Bo8enptgxt4gO9YbWhJ8K2O2HQudYfo",
-	"2fKY6BAsr7gQLL2mIhLTeokeuVbSsqJAAU3HLolHkCq50URRce/sEBgbiW2tMAkhKSfkhVwz5dYdjZLY",
-	"hCz5wnYoCyjMQLOSWTniX0zJagwLh5oGMkIaB6Er/MAXGnwkka8R4z8sQYjt0u1XDzOrV3QihuWFVDQj",
-	"BWcJw7o+YOgcE258iQwfYwomHToVGKACofgu3EMqomXOwGGesEyzWo78WSYXY0KFkKVIWA6wMbPIlVto",
-	"H9gLjjE8sX9DjKLPJ8QN2LDAnEyNgYhnBjSwkeVUrKkwDVQohtBUifo1FCxzrjgQAqyaWvkOEbBu+I0e",
-	"oplMN+jABIpoWF8rY/AqMBc8s0GV14jQxkMEwa9UuCCEMUlZ4dIZSIFvKagPbNfHBQuD7MqlOCE3AEG7",
-	"TZoKaGUl5xnmcswgJARwUySn6j6tRRNgjDGGPsBR8b2nwj6KCL553gPeVQTETUYNO/mnJizlVir3gRnN",
-	"9avdKFJv15VqJZVaSmXIiilIEygrdvBE11Z37vJEQRgDW3G2jluK7IDdNWDsRoSyC2HDMObccRHPejT5",
-	"Z6mRHaAF7UcXmMWZHkNkiPbvC3R+c9RnG48xaFmk7P0YE9e4uwDqiDmraswHArrE0bbMajKjeFBS9t7H",
-	"2tuD6AiOQzgQkFyUWfmijk3gf3cnyU87co5a+udQvfZt1EK56AjWrS8FHmw0lNg50KJgVOk45n7NOsB6",
-	"O7UjHgQocUHsmHGgOmqxvG1upXOSrJZESWnqX2Cw3Ze4836HLXjbwWj61aP2LOzpp7KvE8lHcL+KTrxH",
-	"SEFXyYbFzy+rS1uMgXATSKMX1HPkUoc331TAow9TtQPvv8ZjDPHTkMwcCRHZZgKXdG3A2EE9YDMwwPI4",
-	"GZFg+AbILpr6GGl9YlLKQWl9wu25lWaeZNLer1NRikq/g+pHn7zMW4aDi7JyFmB4wfTc7odlA2oubezV",
-	"016TuM/tfpF2GGp4iN21Hor9wy4C8E0rDf4eLm/bd/4+eRUvHCfal3O5CPSdSiaamH08yJBnQDKeofmK",
-	"sEvIWHQUP1Wf09iHUCERbMVEhcCqEI3fjL7HPYjyCSSX5++NfShnPj3ilg86e28OLyAFvcedKegiGOx3",
-	"HCMziB1KbPYj2MWZ0j0eANtND0Gnn0HUB+BiMRQXLhaPhcvxkuYe4FMSKeF8SL5cSB7bmS63NtFDFrEr",
-	"ae4W2MdIpHjP9kGyI43ifbcafZtKYvln3P1dpeZtWHPaWqMlFeluhumScfyMjQ9wYPonpCTafVtspS8a",
-	"6DTt0PN+09qnJBo2XjODUdSFyaE/9ss19gGzSmbdDBu85tpcer7v4g1Zgqt6chpw/lKmJ7XEMGA+6wpo",
-	"44Z2+hUaby/jHM3rtPKkczh66H1ruC8fwIWPM4Hgqf6RQyce6k7f7afZt3J1r5qWntG1IblrhJoF74QO",
-	"wr5vEqIJQwYOl+pvKowk6DhWxSDWXT8VVJQAh+fqVwCO4P6+ZILQ4GC+CdZogKbJbAO1W0/nPB2TkPvN",
-	"kg5JZFbmwiWVc67VsaX/qAdukDuwVKZhcv7ox9EdxN1H7yA/qBb19RzFzqCLpu/0589GhzLEvt2o+ZHv",
-	"uxduGXt2Alv0s0bc0eqIb3zqfywjYzTyAjAweG4w5yxLdS39JhRttl8sV8CvqH9PuU64SDwvSpmxQEWV",
-	"cwptImgCgSiZdzx9hyA8JxGk+g2MVag8SlHfG/Km2E/GeZgARsJzsaoJqj+p2HilJZq03Hx8XiyvI4FU",
-	"klNh54QZXE/I5byNj0SvW0QHF8/+nEihOeaUoXZdpgJ7QD1NTXSJChlgnOj7JpjGbkZRjgHf6K5Mc+bX",
-	"5FMzw+Mfm30PjOO0fQymlT0P38HOfos15bSheQHaPIz+i1rmGxw3OiJU7vqFbc4VSzEivn3ElsYU+ofT",
-	"0/V6fbL+7kSqxent9emazWhplmLy9PR/8rkVRIr7JECJhWpWNZOkOjOGJss8HlM/HmEqAPsyF5pLcd1y",
-	"dqkWFqmnBUHR9WXHF+e0M6R4WMD32neqkcwuA/zIY1Eb0/WOUkh7L86d1Q7DtPR+W8Nwb1KemJTNJ1ik",
-	"7Z5tqk3yRkFXsSu2Z8ZYShuiwDurmp5LsWIbCjrMugahQQE3zKmZ9tqH0OvcMjfFKYYv0SxjYhGncfYe",
-	"7G3VqurhV1V7S7yOUqrYzcU8xeo9ZsWlCJSuz4HyL0VRGlChFuXMjQ+RnA/CvYoFjeGuigNAXhfPhfFF",
-	"wHjOZNmhjir1gMxabfhvNFN+hG0XP8v+AGydAqL7HVnGgSewtt0H8MWes5cGwDGLbpxzGUWFLqQyTSrw",
-	"18QM9AB2zZWgEHU8T2CJZlCbDj8vNzPF4078rdp5Q67G9pJFb0l3PXZ42PfT6nEXvsrYHuN32aK28u7C",
-	"fZylsEMNXAvnB3fQLbBzPZzHXM8dkGVy/VG4Zz8fV0XHhb6T7/wKhScr9w5/YKx0L0tFF6BJK+CuUi7+",
-	"zO3X210m+grnoZvpOeaRt7FgAHY4NxHxd25cvB1+cL3wuu/c7KZ0zM0O2wjbwDaTexb3Jem/R4677pa+",
-	"Olc+5brIaLdG4UE7U3+u1wfq3ienr3+gUX/Lp4HLgcrwZ1zCIcc37plzjSsUSyDGsyu+ae6NaQMtGVt2",
-	"ugDBpV8fDCFY1z6MD7ZJ5LSDl8ElzQbUa4rl14TqmwdG7DzE8JHx+4G5R6sCgC7T6yG2WD/dx0hqs2Wf",
-	"QaPJsD7XMgs7cVS7TnUwdpp3xnDs6mejTuWNnarTmt8Lnwr1w05WEQ7T8a2TB5/rqPWhgtZhqmzPiovF",
-	"Y83qAF7TMysLbcCs9lPCNi6EmA52G/Tx18olGNgP1y7bE0KKLxN48EQ8qQ52i4Ja6IP8hp5Dy6MUXcVB",
-	"gyNP7OzWhowW4hWLjG0Xcq8c+9FrztcmPyGXgsxLUyrmvJvXPMugEC8tFzkTxhsZKQHf73kJMZgZSxcs",
-	"JUmpjczdYHqjtyurVnchIN2qoNLA/drXSwfLmotFyzbobO3qC2/Xp297Fuy9a1u7gP071/3FjsINoeg7",
-	"ria4LS6pJkvqQqMLJosM3I4HHWGk6sjRvWY07YrFvqzVcKUzWZqq1BVmUnAZR9FzuapLBG9EyLtVU+K5",
-	"MCkwK9hmUN/MJ+NqNEM4G6xRIKSZQkaBugc8ZrWqURpAmfkEPVU5rVvnVA2LH7MnZFSbO8h3Esu2AzYZ",
-	"Nx9X80hsIesDjYleyjU6CFmYIUnPZirg7+0pUIfOsFw9LirgTvOo58xheFZFlcFi48YgMAbuQAzzeJzS",
-	"tiNQfVm30Y8fikYC+tYMf2zH09RK2JWa6TFWZqMryiEHAoHSCpTcsDxl7wmHkoRizheld+yuShWn7D2m",
-	"9E19secSvJAyaviKg5lQtuoTVAofiCz+w8ZojQeERfeUSWHraMiRJRsoLHJCXMxXTjekitoSLoAOwq8E",
-	"pmPwp3fjIvFDzYt3PsnQu2CZRZNqLUEGnuipqLXFMLvc8vUZa2AJ1kkIyGzTbJ3oimzTn7T4I4RE+Pns",
-	"Z9c8sE4pzOdt11rsJRUi1UevlEBRHWWsdk82AFdS7l87Djrt6329bTFwA9ehdS5cdYPGUhOk0ZjUgfy6",
-	"yak9k8ZqV2umGMlpytDDgJoqB4XcybLH9cQJuwvvqyqipQZ591VQr+GGi9Gxis7o/kg8FAe4ZvPBXFGq",
-	"WpRrB8L9zAOvqw5XAqoWbH/Kdt18nN1g7+dfbId2WQCPQxNw93z3ZRB2T+McwgE7/kMRs8ENRK4rHQhA",
-	"GJYdDQH1B9ahYmaIEq6528PylSEGfZnK6tT8w3E86TvGCAdsr8MwfH1iD2zcr4O7H7LIf+zz25ufsjGR",
-	"mn2rnlGRJvdCrvFxjg4pMlt1pFa8ZhqktF/Y5hpxy6Oh7MONOspBvGcbVUFs2HQOMsaNR9cye8woRQu+",
-	"78qQGdt1YWSyVPuYecajIqRG2SOLSjzTImqNHRJNyF3z2e9CkHH1oQfUlZ5qkMa9UrW3BLmuIAfbpZ9x",
-	"f/wNiSL5RZDLo6bvvqWL4Qe7bicbJg7e0kX3E9nQBcYeZHTGMpf+zWU1KUDkhTBwqMIpFaQLADFaqgUV",
-	"XDMyFaBqqAq5weN3Uw9UsO3nPDMuw4NLNlLTYriK8Ld04d1yneuwhmR2vra8TzZgUQ7JrLnRGMQ8JlpO",
-	"BWS5+63kUPxoyehq4wOq+TyEZtWjpl0ENOSvoCTji6Vhyr5O7L983o0xJNegpL74PueGy8QSQq3tJGCG",
-	"rCuu+pYuzgP1tx8vSJSh4FYXydib9TxeDP228eyCCVpIIVgGVI9N0LWX1S0FG83lhe5T8kKxsssLPViL",
-	"uyVLbLFRN2gXFz2sQuPQSmKuskXHQtKc7dyMUBhj6HXih4wvRZe0e0Ducr2XqBZdN5DREFbH6h2QRiHC",
-	"x3qSIgTTTS03jT1ptbw3udTGa0B9YiRIf5RK8cSXkPV5EDwV49mgWsuEU1OdDwab3Xl8W1kR+k7J4BPS",
-	"WMg4YezKmVDdqjsGcgzIEcld4hnJjm4V0xnofxDofMcFXMMiSmOYV2c4dUH7+moerRrFwIydkbSh+6Xu",
-	"xCkcXcMb0vzuxUn21QtjWridqFWJ7w6rQXRIyomPXEStu+og4rXfrdEi6zaTCFCPr55yOcCGYRm/gh2E",
-	"PpIHjXaEp2LfJ1bqQPOZL+kDsrdmBcWqELMNoSSlekn+X0x67BKW51Tdg6TJNVZQ0oSJtJBcGI2ZdnQh",
-	"BUirK4o1zedS5Q1DMYx+MhVT8WNVjHNMFnzFaualcIlcXpB3sezn73xF+6kA5N8ZWUy+/WaSyxVneoJg",
-	"3o2rHOBgJy5FypQ2tutMuhEAwx+mIjrMJAoWxo6jNRU+W1AruzvkfqwU8v3Z3aMDb6V8nxSKzfl7lk7u",
-	"2YzOQIyeOKFqW8gaj95PFnLSlryQYI6dGOxPHvkJMp1t87bP1CS9NY2elzfyiipdSEiXCJKsi3vlLTeW",
-	"wGVmZb1oej2ZOz7Xa+Zkn2nzjWbzMnP18QQWmCMZVQs2FRnE/MOwIRMc2sE1N6VzWwC/hI0sSUyotoTd",
-	"JTPHVqUtvQ48d+euXeMidG4bRbbpLz7lFta5hziTf9MqOMyvJXOJoAbnqjv00IMvylBNf/CICtb5wTaC",
-	"YAoezmj6H9xusluZuAD0FnLNbFzd0tKtZ2axzTUZq9/VzaTVP7Msk2QtVZb+j9huWn4WETrWbEZomiqm",
-	"dZ0wsNhlG8hWDE7LqDCnIJI1tP6HmhpKzdSqNtiR7Q2/Njh7AKboHFKVAb9wUFacrTH0MON6uROez03R",
-	"wQWOInbXgMSo6e9sdmbXsx5Ac3gAMu6LToyYdMYcT0LEbCxDjUfjgEizbcxbhzDAbi/Eh/FIs6RU3KWg",
-	"cO7CUCjj7h7RgaGh/C6jCqPyEYhdEcjcqeTaxbxyu1KJlPc8ePJbEkAhdqIZZsGvHi0Fd7lY/DruBhJW",
-	"vBPaB4gcmUtfzt65RDtAz6gSdLYhvzAmWCt14yhI3KARysjZ1SXmjC55lmKR/zwvBTcbkiqQ+ouMGpDC",
-	"nRY7QLBdw/VMU0zoK4lmORWGJ163bIHOSgNlfsC5skBfFUqUzKDoKdR4YQtMZEx8JFFwI/Q6spli9B5Q",
-	"hDRCkNiD66rWTCqFfQRx4QvIOIdiRVK2YpksLOfwNYgw+wU6xs2YA4kFapwTtBXd63MIWDqZAz2qT8ib",
-	"zPCcGpZtxi6RCBRKJmu6qdbKKJrcaw8OEi3buxfSTUMmEEj5RDSzd3bGqGaogA4e0k7uwOshUIu9ehDk",
-	"6IfR6tuTp389eTpJqKAKqE4WTNCCj34YfXfy7QlWyDVLOAOnoerRD7+PFiwiUPzETEtC827EleN21DHK",
-	"3kwh18llai8a/PATM7UcCjD202++6WIKod1p1f31L3Zi333z/e5Or6R5KVP7fEltn++/+XZ3nzcCnfK5",
-	"9p2GDfSjLEWKp81dgbs6Xbro7hu45J4rJdE9CwWTf4zC/ryFnNomWba3CIv9HX2XXA1BZLNMm2c9L8yq",
-	"Ca/2yQH48ICtRhC425/vzn0YVwftVLNsfmqRnOTMLGXaffSumVGcrRgY7PCtRBtZJrz9UGkfuDHPwGoI",
-	"xSygkPSSJ8upkMLlmKOJ4Ss2mDSA20SJw4oVV250EIofsMnbsPx2D4DwzL62gPQ+zd6d/m7/usO/7nj6",
-	"AXcRysRF6ubZ31Hx5Oqc2bVubimCwgCSWsUyd8txPRVcKQbsfpYxspRr+wfhczR3xqFx7arOZBuimL0c",
-	"IfTDj+WooebPynU9SxXPMjKnPPNU9v0335AZPOph6XeQyUsYBSeP1dlDIoh/ODHI3keVENRc0kbRZowp",
-	"rkpjb0dUv/03IsMVNRTE0ULGrHNvikxaOUsQbFlt8163wA0zZzhSa+tik6uanDpN4wsmFmY5wq057CKp",
-	"cOi4S7aK4H9x14U9spioI77XZylsNDTz73iv79lvu59bEGdp+oBrP4B4yMUPQJq3/97n8CAK+Jgbevo7",
-	"/P/O7diu++MaKmy3N7q6K/bfaoS599n2e2zHv7yA3D6jLuYbP5xfyG7+7v51h/7RH2psufM51WbJNWlg",
-	"99PpQHbcyGbRv2NDX2EVU/5CmG1rN8Ex9fR3+79hp9MpNBgeylpedIIpI3SobGL3vV68kSRUQCRtaV/9",
-	"DQnshJylORfaNSFYah+PvP1QG9EsWa5ZtvJeeVEiQlTB1XdfKgIHaX/gxx+d6L6M9+B4VJTxWzyQTzOp",
-	"/m7iqTx7p8JRSYSOegT1NP2THj4LHnQ6o6kvrdbPibCaVbqoWANxiTXcazKobWsMJbASjAcOb0J4O9pf",
-	"VlyXNEPAE5djoO236EH1cSGZMUT1GczoT9L747CiC6YXnIq2tgLIA+t5ImU5CSYQ1msBVfdw96fCKda1",
-	"FXt6et0w49OVbA3A9dROjyuWbQhl2iyZ4Qno7QP5LhSU/hQbKxJz5y5Vi3U4IZZWdMDGF3733BTKx1XN",
-	"CRdEqhTrkXnnfqoRIb2Dom+Y+ZOc/2Cc1ElunQJ5ygzlWSV9N9Tosw25vDghzsYdSrcC+VY0MxWNQttE",
-	"KtKotA1OKV5P22yaUEFWnK0tGU5FiA5f0lB9uAGpFhRillKzCMiTqYBjmNekhi0gYVD0fWl+9CvYQ+q/",
-	"Olv4IU+QXQ/G/YxABxLrd7s7/SjVjKcpE38s8rYS/wCbAZZBhGQqSMgaeawG7suFNjTL3PPidruO7VSg",
-	"Pd3yXDCTg7I5Zl0CQCJhteoL+CiZWIkB6NkpozQTmoP5oYnXV0ysuJIC7LIrqjidZUx/7YKoEOcoJdpR",
-	"3MWhDzYpbgF5AE0db8Nhh3cb/IQUEyZWg7e5fwUfYO6LgPnw4M34vJV/bgvDgT3FczC5Z5tug98Lrg0c",
-	"XHdobONw0FAICuctGIS2cyoZORWoFfCMw5f48EGKORV0wZqD2AcCXgW9zN/CPYN+v7DN4Xa/FpgHbPO+",
-	"jPzj7DEIH869aLfmaCXvmXvvuy1x2wumN57nLOXgW0K4WNGMB3v/Pds4F5apcEnWSCbFgikUXIEiwAum",
-	"YRfcvbdd5rrdNzz277njB92jNTf1z58qgq9d3HoDrm0Mq/KQXKbe7oodQ8itfREJUkuKVpQKq5o3N/Jl",
-	"gHDmKmuwAxl7B6Q2bx/Aas/KlJvnKyYMQkm/HM5uZzZhdmq7WLttSbBlU4AKkthZvQm4ixGeF1IZKowV",
-	"plzCSXrP4GUSWDxWB8zYCh629cdsIB+LbHhfOJ863GCpNFTdo5mWVUw6s3MnmXSiBOb8JL4cxVRUy+fi",
-	"6WWZpT7Lo4sywgsHXE2gDgpJuWKJyTYeramY0eR+oazUTP4pZ+BnUNp3lsv0V5NsuNZlx/s7EJe7k/bj",
-	"Wi4kjEvxXyUDn7ndjC6MeLsp2I8Q9n9IZ56zayoWzPU97EXUmP0Xea5Of4e/7uCv3vd8zWnKaa2S+rlz",
-	"z/keCsKXxJ7XXuj9wLdtHYvPUxfT2sYZFW2ldp849BP4G9eUz1iezqVaH9eV1eFXSHbLTjqFGqhXQMWB",
-	"1u5HMJ1+1ps77hBkXD78muWKTIiWc0Nwr73ZgYNciqpliuEL/jqsFGByLVADm8kFsa8ZkTqTFgvO6HBr",
-	"3TNW6Aa9SDEViiVSoW9bxsU9xYKzXnTSkrxBt3XxxKBLOcAKKmX0BJ8KKjZmCcUmM81qGZb9UCEfiv0N",
-	"zOFjSKUxJswkfWK2o8ggnP1JkQ9mN1ozowc4xKWV9z+BlxUBI0R7qyxA7PVQ57cBMoEd7BXN2WAp4ooq",
-	"Jgz0u7yoyw/7qm9q0zxMbVMB+EOoz5AO6kRx+jv8/87usz2d3eLDhVyL4Ddp+1iBgRsdFxpsg4PkBdvx",
-	"iprlg46uG/3zPLiNTSrN8hhe8CdVpI0uC8gQSiiZs/VUrOkGi4LXXOTGqPZy9QYKqvVaqhSavT4rzRJY",
-	"hQ+hw7trKnwuBWJYlkE1c3zYgKc9gCcJLfBW85XdmbD3XRp/uRzDj/6P57lsd7Ta3IdrP6OujVD7vdlh",
-	"KuAtCnUJIBwMHo1plxaV8Dkm2RDZBn3oQ3GEqagOrE+GDqMBXi6xRa2SQl1atczD3kwdBrSHqk8/e80p",
-	"Usd4iD6s2tsdDuzkrEY2VDFwpEu3zzyEK7pN00QKMmNLms29y0plAHYBgFOxUFSUGfVJ/dSKJ2wyV5yJ",
-	"NMPwPrO0+01cpCbBmE7InlJHSS8tKwhZ78GrB4WRmrLGSZJyLWoUZaVQR6KO1RGKA0tM2CfIuzPk6/8C",
-	"OntHloymTIHaR0BTOZ8KUN3QBAODfO6WehxnC2dQCEESGfA6fl9wtSGofJbercNK6DznhizlGnTPhNrO",
-	"4IZWT43Y2AW6oPYIAgY4cPc5OVx/uQ3iw4NOGwL5nM6bD3oGkSTEL/8D64Tt5tSfoQ3jT/PFkS9uiDSY",
-	"eNnIAsKrO8653RyCLEWgvQtX8CyjKn1QuZU1Aho65SQH9doCdUNBIMJBvKE0S+jcgPolBxj176zmC4F+",
-	"JR26Hb4QEPMu8SrgTaHHRQa6fbS3mgN8Et3Kxsrf4NDH2MQDWXxpljclnP0vdWvLou/ULriGtMVe4jrK",
-	"lpbF3vz3Uqw4+hA7jUadC/9haOOP86yCvTnO0RW1jQ4pB8OOk9slmworK6+4y9oMoQXhNZyygokUJGor",
-	"BzY8w7gmVVmvE3I5nwoY63+Ha8LFJ4ekPS5ueWyf1yBN2xaKmVIJZiVhonFHpgJSisxJThc8AUUvvrgD",
-	"pLF79Tk0Qb7QhioUPROZMjLP5LrrygECOgJ/+pMvNcn1YHa0m0zDX9N6qihItQI0ysBAsINKUd4Mz6+m",
-	"vglt7XWJhWnyVSDmla6R48nX9k0Fxf+AEBuBm0uqnb0cagPitJFm62cLiZaJdCooqafCcuBCDgDXFF5t",
-	"eFpaz1JwD5vThGeW0cJBmTRAlpouQva4mp1l3sZ/KmimGE03yFP0GHPXNIYDhMAfwB3eum99ocAmOxVU",
-	"zbhRVAWHBjDUKJlhltOcZjzhstSEJkYqqGQKS5RQzcYVYu794KVMeGRWL114dr++vaqyX1DNXAbtUO1y",
-	"SfVUJBmjClP+ceVmAokC9ZqbZMlSkrIVTxgkNFpSsCFtmHF7Yz+XuNDwrncF9HDpICFRyjK+YmrjnR2q",
-	"CWkmwoz89icU3DZcAMV0BJE2aYQQpqNa0oaaOy5SVggBm4pLl7qIK23cGlLy9JtviD/a9jA4VUMtzWtz",
-	"a8dT4av4sUSKNAD6/unTbkCY2jGiKvFWX0jAio6NVJByqzxppX2FhoovFkzpii3YRa89MiCwAxyZPc2O",
-	"7Sl5+ebm1lLJktEVzzZE2ZMASoxuJW24Cf4oYs2nE2e+f/q0zbV/bfMl2AV7RGpswR9QTxQnH+HCgZOy",
-	"6b5wAPVNO66+1BiSZOS9J8011dgIdVpSeFYZ7NZPdOtqcBEj9iW84pTY+4+UBbCC1J6LjBqmeukOMXyQ",
-	"BOJA/CmHmOVpJhcSo96ihogrpjAjNiU/395eEWxuryK4GDxD37rprESiGPq3QRM5FU7PUZWRLKgVYlD4",
-	"nCtQEqVPNHn39+fP7s4uLq6f39y8OyG3m4InNIOAS16FrVHHae096XBSsjTMijN1gAQMWnkIx/TVbqYC",
-	"vW+ALfrGk+Al5UAaqu915V0umN12OyQXwOL1VFR3ZjWkJqoUoLW2lw9J+RxSphoiFV/g48Mpe70SfSqC",
-	"a2LBTzQ37CSRuRWfwr9nLKGlZuTcrvvkhhs2uaCGVmWFpwI13Sj12xt+4sazhJJxjAtMyRry+K6luieJ",
-	"klq7VjstckgoLX6/RS92U10lYuYn2thSzLuOtEGMPCGvJCg/q8vOinZAHOjNL9CRkmLG4TfXL2riUmMG",
-	"4A8Mf9tFsyIvjqJBZLMwPKcdBwzAwtnED+orQ9kjXBLIyvQb+BSEtEy++2ifBEzfffM0JuGHpajpAO0s",
-	"pSJLmTPAZDQeuc21EM5psmSTcxQLQ8LOKA7j0Ra97Gr+QuK9tavdDTOTc8zY2dvyw6HKdwn//R3+d+fN",
-	"2h9OLS+Y0eS++woDe/VT4hu2NTSv62R97uHtK8g0oBwmv8QR+fNaMstT/4KEbY5HflW+kRHD8xIeCOEd",
-	"2ny1jkkZ0kRORWgkBTo/7VC5PyA4rA3l32qz92ADXfbw3k0PHovg8tC9/VNB07T7u88UaCSqEdyTDyt+",
-	"BP3KDip5gKW2DeVPKtlxWQw1yp1bSYiZOnFM0C43z+S665UTXu0oz0wFBpPDC4Y6u57bw5rWwUt07+Lm",
-	"tXeDTHsPJaBeS96/55VyJPNeqe3oORtgDjqOce9Pu17nbh5u0TtwF/8Aiq8v2JRXLKVgPecz2Ky27m3g",
-	"4W5jAYYriYq2EHzwq6YJQQqs9oLmL/deDfy+DsR5teYlumqJmgMHpofCkHHsUulmJSqnMV+Ug2RpreH2",
-	"05Nj+srCc4t+LlP2SemuhcwXSnvRGK1ocqggUADd1MklRpuzDXF1673izNPfVCABepGj7hpkedQTjdA7",
-	"SeQG4B5EIZ0BNIdQRw2PL484fCUSCKVQQ+RMsK2Fwi0E+4FNSqSo/Q6CRme6U+92/5LeszMP4BApIg7o",
-	"3/dxUZWg6X9dbG17lDtEtefVTeWXvkYBYFZvy5fd+/8TM/Xt/0RRcjFsvgiJMuxyTu/ZgKMdtrRuUwbL",
-	"CJRngoeklTir499/tKv6Tp/0ju9A6fNl5g878pYYHnTgG9Thgy1nm4b+qk4jkQvew/KS1+GEcnQu0ELp",
-	"D3VpzxhNZM9L/4wk1CTLCc2ySmQHlxhFEyisBWU3IaBeV5Y2AmHQLtUHhNdAmLTiVtrLvNg2LwWkKAE7",
-	"4LYP0W3Dq4lrMueKYXDLXKoFM82snt6DSWxIzqgFOS9d9U1y6Ry6rDQBOipw+4BQk6C7fCfoii+okerE",
-	"vnyewbq8AwskF8Qp2TQmxFL3bn6VUXIp12ROFUnlulbzmLqkiqBst7+MibTPJAZrJBWa7abiBZ+BP9MV",
-	"XbCqNtmKa25YShRLsJyXnUhON+S3kpUoOIGNEqLWqWFqKtzpgSODdlbIeFpSRYVhqJ9DfwrbjKWNSAt7",
-	"20JMXeyE3YRFOUSucj3bLDJi7ztLElYYdnRppsbLcq4TdwBceVVXc7A7C38VTpplpOrkrelghG4tmq/J",
-	"enDwXh0A8o2jMYHaxAcE1/kqtEBsUi2o4EBlUFW3e+KH6/i3IHx4yOo9OBbrUwaoN/apSbGnv/ttudNZ",
-	"uRhQTqq2kyfkLMtcVWQePCTdLnvHq1yuqqRMlfHdUGDAAVTn/h8YWeW732Tl4gGC2hYWD6IhhPFxaejT",
-	"Sf5bzKGTLcYqce+mikOSIHSRxKH7+cCykH+QjelP+VrtxRNd36runQmW+096Xh9i+W/C+PJ5/mkhNffu",
-	"SLtLftYIwnf0tWmNYuyE/LcsQcbElEYok0PqmKlA2+87/PPd2EqYp1A0P0Cqj0BoLsUCsqFoPsvgOQAQ",
-	"psK5uL6bsblU7J0VPN/RuWHqHSQ+364oaEWOVNHFhIp0kipZuOD0OU3iCf6aNHDlF+gPQdUBmw/HkQf/",
-	"ze4iOAy1qvg704PUGjvnBQxmyAwD31xXiTzCEkPHg7JENvQIdY3TeEA2KD/yz1RfGpa3FFZ7k01jLp5w",
-	"PtmG1vZvyNMjNAdOkECCT//0IKVIWV+ijxh7CAAf8DzZhvHhYfvSfKJ80runsTtb5+309+qPu5yq+4Fv",
-	"jmoL5VqwlMw2+5QgrJbp0PdEAPCSqvtDChB+Xhxz64D1aDVqO1OlLiPnNabpErO4wCipSKH4yp5M7Vy9",
-	"QvQWPBoxbJJI4XNcVXmOcqzEX3NNRCWVC4nxj8oKI67dsGM/6NjRj1OdNYlpyIk/6OmxB/UMPe+faya2",
-	"Fu/e9QA51sk/9GXSuXcHM/wHvU62oHwBNLDzhjgVMrXvFvu/oUVriYBYe6iKWaMhdFOq0RT4Gs1Yg7aq",
-	"pLBthtPPHHD0V4d4iETpbLeoZ8d6WBLoGPZfBmcpu0pXI3EYuT9pVEH6EdIAAADaXXkhHlgvWYpfwCFh",
-	"A/9Gk1b1fVZu3UdbrE/1095Zmn6uhOdQ/7fgZfDoOP3d/m8wL7ONPxEvu5LafCySsmMdl5dZiF86LwPi",
-	"eBxeBqCjvAy+yDn8es9FupM1fa505FD/QlhTSg1dKFp0pz8GTZHLPUpVsvQp7NuS9YWHdQMN9y9Aitly",
-	"Uuw+OA15GPYXLtL9e2Hi0v37eb3p4J63dPGK5uwF12Y/5V2rOsxBFLy1O58l/VbUukW9p1Tfd1Lwmb4n",
-	"6PYFGW5DCYVE5nkpuNk80QOI+kzffyyKxsT6/+VQvrx46I6f6fsvbLtzapJlj38NMi27yaEPqOVzOyl0",
-	"NtsUjC7B0SxhgiouddtBbCowG0ICiRXWSyYIJe9unp9dn/98d3X9+tfLi+fX79AlLSR8n1NtfBJaV/Tw",
-	"ZCqaFU5DxvgQsPgsgxTzIiXXLOUaUhLdtrNwhaxaORfoOKEZ3LuK6TIzuqpv5UuuT0Utq5hj4ZBtYRzy",
-	"IS1rsRF2vWZU+1IpOb1nGjLj6pKbkAm3wAwlkLesKqtaajaBDB1VVZJNwSZumWHo8VT8B8mZ8D566NVm",
-	"qX/B9Jic316/+N+/EG02GbPNSj12dcIUZmm6dtPEnL64nHZPrMjxjsw5y7DAhl5KZfypHsNLyqX2NbAg",
-	"hnJBkC5YumCafBVygQDx6yUvxpjPDyupfO1yalmY2ijKhbHkgS6GYDHINnZC9RXGJO0SCsSQgm6groPm",
-	"/7ILlNMsiz/gwrF96Yj8E96jD+M7bgJfBu+RSTe7aR5UPKOYUfJ1wcTZ1SVJZVJWCXF8Arh67nMCxfEE",
-	"CUnSV4z8fPvyBUEfiyohTqnZvMzQhM1WLLPUo8l6Kcmausg49r7IpMuQY0EDHTJtAo46nP214nD2E5lG",
-	"45x+YubCTj1OCO6AQQ4S9t6cLk2+IzcK7FHbHHJkr0xd5jlVG3v5by/+KOqzOah6Ypb5woh7mX0Prwu4",
-	"t9xwDEGxVcjvUx1BtycD6zRA6xMCmS6pcMX2uMbAEAapXJ0LNXd1BdyXqUCzUqg7abc1Z1Rg8Y+U66TE",
-	"RFsrTtGtxbliQ8KtItvYMxb1GqmVGz3EIlzv/uHgrfzj2IHDhlYn7vR3rKg42PDrdrbjlB1ozIW+/xZ2",
-	"3NqZ6jbhiqpUZVflqYMrVQ5c6gF0/bnaO+tsrd/U6WndJ7/1xQNRzLWiADT0+Xq5JtpIhQmq0f7tGJXW",
-	"MuFgFg3BKQB5TBR1sTVUVD/bXWfZ/IRcmieaTEUhteZY2r1K4gSp4wB8eHI4bz4n07+r/O26meOBNtgo",
-	"FR3CXR9iea0B+LwJsYMd2wU3POEFxQK4LhxvsI2i6u1MFYGeb6AAUQkFiDSBdbyqWuOS+iyPQopJToUV",
-	"bRYu9kmDOyk8zatarLlm2YppSG0INT8nruZnF+nVRjywLOs2FY6HuvDt0kV/WRdNn6miRiMu888Kc3Z6",
-	"b+F6sHat9RPtKu5COun5gFJomNoxSzV5efbq7Kfnd89/ff7q9qZW/WoMKUU2YN9o+irjqD6YtGAKKus5",
-	"a0eo//XastI116wOCKi0gsYVkWvRCROm8yO4IkWo/it+wk4wwM9PqkrUuZTafI0XwZpn2VTMJdbNIvbt",
-	"lRimcMVITpMlFyw8Qpu42Dal9lcO1KBrffVBgJoZ8pWQWxBc4VtIvM00E+ZrItVUuFJd01HKkowLlk5H",
-	"YydqQzxjONLQEFbKjQa9Qgrb6WgqXKE8pJVCZjzZwO3nh+BixQ27s+Cmo/rGENgXO5Rtyw1kyJ2OqDFM",
-	"pFwsbGtHTQ4teCxgknkHvsq5rJmLUvUbXvNy563ZYnGz2M5aQqlq/rrJK5mxUOXPHUtQSXp0GbMrCEvW",
-	"opQaCdePGNR8qx8Zt4JNatyxnpiIx42EVdaG7RsBjYXP0MFVc9wD0EoyqZGOuGUIlAg5kYXTE7oKexBo",
-	"BsU7tCxVwiBPL09ZXkiQpTDBIE/RcywLboQzEBJOpuLSEJoYjcnv8ck4kWri5CCa+GT3TWwt2SBfmJSC",
-	"/1YOuoaOJAwdeA0dIj61kf/w5d9oVlziYi57o3stGc+o5onls2WOZT6yzFGHmMtKR85N9v+zdy3Nbdxa",
-	"+q+gtHFSQ5MzdWs2mZqFY8cZ3XEcl+Tc2XDKBruhJiIQ4AXQohmX/vstnINXkyDZasqWKHsTxVIDjcY5",
-	"eJzX97ERyboI3N0hamA8DnPkEoiuRmrcRlNrfrPBZg54z5DHbmx7dTWVgl+jN/JXcHovmKU1tXRErugN",
-	"r9w7YRymMxAzwvx4TVeCabPDP3ju5mLIBdq3/SIewIKPz836ZEalZLqH6NxjhC9oUyjC/hn++isbSJ/a",
-	"4U3+st896s9FHslovJY+M71mIfKTfwne73vbNu5tF9jUJ74X66LfNAvVqF2TfF4p+X2K5eSz++8Hw//a",
-	"AwQTFi/OZ+U22t2TOsR55dpd8r/YvRCmf40NLyAUmR7s5hBQjQ0OkR13Ql5T2Y1LmblahQAJsBqhhz3v",
-	"Hu7LABkNqHtQ5y2jL15JZjIOberxOw5be7lxNMpz2j7wmgCfAAF5kqkMGXDsn23Cjzl/FWBjsv4D0UZi",
-	"WDl/1d/w3DuMBV0n5Bg4tL04NkVBSeTJKBicaKuVUwUKcvWc5a6X4qGeoK2OKVQswGLddcV0B3KS18Z8",
-	"ER4OZclMVoeW4AWMoTbRqTuVWWN3u/PrboMIGzMY2soSGi6UN0zWSkcqlqnsAGj9cfEmi3imdzwz3nC6",
-	"4nGN5+/i0pmHQhjU7KzH5BkGwHdZIyt3zoC+cndbsDvr/So6PL621cftcTp6dKTtsWjpxuEx+Zz+ccj9",
-	"m+J0qc2YvLiyzBv/YN9wG3weXlfGewQ8MKiX4/M9eXfr5i6z/6xHl5KlXHgvZr7r+KhfWtmlwx73DciN",
-	"A3SwmXdWbWw17iKQ9x1eijANCOFcCc7gUO3sELtIUZNUB13geutE3zV/qlHI7QUv+DUzd69GMQBkds0m",
-	"N8qymHVYPrOSz1kZC9hz6Kr26YTheGHasOBdRy+mCfezdAWjolGa2/liTF4Io8A1mvx6IwIUpEvI8HDq",
-	"6EuJlbshzuGmBlvSjOGtzX0SBE6roqfuDb+G0pGBgaI+9QdPYBMCDdq//TDwVLn7JzwcFcLzvIBavFWW",
-	"LDGVidXkhzWz4x93SmTILnB8OUj29hOX1J7gXFrVUEyEwnlBptB6euYjPNauyaKt5mQ1p5asVfusJuzT",
-	"klWw2qcS4DNVzbQkkIUgIiDnKBIGI5AU5tMxt12EtR0CIDm7pWaVWiyYrP0FMiOaFT5QGLYYnwjBNVlq",
-	"5WmmzpPvP+EBYah5336xb1d4Udfft4T9ipYdMCgJ0x/ft7tvgIMH9g6fgxI3D+wYwE7hN+OywPCxIftG",
-	"Ccj3a2Vldof+BHRBXvdIt0VC/Ttl277h8vp0km3DaB861xblsds/EU4EeR1uYrF6isyUul5QHUlbEwe8",
-	"qTRdsjx3bSr9mjXc2/vQp09Kt2pE+BUJ+WYxFu/5O1iNT4NzDZwdVHD/uytAQaYWiOg1o0ZJ8kN44o+L",
-	"NwRdHq2GivslbRgCONP6RzBDZEyWh+FfUS6w5DVEyuJVJQwBSjww2c4g3HruE9wYcpeoPh58M7SUC0fS",
-	"aCpbKULAYKbqNfFlK4bQugbANyri6DyDOzPIKm9GcajPzFTGbwgv9YmDKR1QslX60pB14KaNG9JKvISj",
-	"+xUTrOMsxO+E0xwxtY2F4DyjkPeAzh9MCgPeX9os2A7Ho1sOw/05WevboYvx8WRLhyUZt8vJZ/cj4fLu",
-	"jYEES3vDd+x6GJNLH3rGaw8kT4Cf3a19Vo+CFz7kTBh8xLVFs94piLPsF06gli/CE9CJWjJZ9tm5+R1y",
-	"7rp2x4K0+nc/ln3WCRVAbPafgfBIdv7hTQdPQTMmL7veFkCwR8ZmQN4siOCtqtmDnI6j4vdBag7AoDiV",
-	"AnDFOReIjAJne4kH2qP+dGigS8PBv5rJefRkOVFssUc4Rfa5pFhbmEEipDSeXYPBxd97LJ0rJA7nwEz+",
-	"gxuOSR29b5zvNWOv2NLO74Td4gTyGmrNjllnoaeHXmi4uPrUDgEsVI4CGW8KNbmWaiVY3TgTuAGKhV2L",
-	"aviplbW+HTrjj+fUCvMeNziP0tUfTT5uB3hlCHuCZhLJgY1HD3b3OK1UoRTIzcjAoIFrmh01fVAddMNs",
-	"aHaMKZBGfZLWXVpwe7AhQbY+wACXctE2ZfkNuSfcWXiwdLxyXSptv7JN77/zGND4E1WRQxiP7smyXgzM",
-	"kd1Qjf8fuE8fUy6U2p/0+i5u7EDSB0VC7mffEiEk5otAZruFjg0gferLbwrwmuPCA09E1PuiA0F2EBrY",
-	"LbkXdf1dbI9ihYZL1H5OKu9gjzcudUWotzrh7E6maORr9taoJ/NusGbIS8V7BPOsgCvgd5A1unHjy7yp",
-	"65Pv4I1TiVdBQzZgMRCHBp0XWT1W/hZqSKVEuyiXngYjJZz9p3TTGN23qb4Dl+xerL8nuH4mXuPWz5PF",
-	"v/c6Y8JygVYEWwVFzxdadIYgj1b4E6AM0bD80Glu6IKFngCPKa0C9GK4tcWBNtCtlecQsZXJBe7W6ozN",
-	"6Q1XrR6TS8bAYf8TSVvgOz/gS3jLjkWEjwbF7jZ52DvaxliOvLF1e3uK2p2AfMr+kl+ZdMJHRVZui41o",
-	"BD4ukrjcUIf/z+NtEVrZlgqxnspFa0OaZ/fpEZREMFpvoJzhy6ggM2oyXAPV2mUb742CyqalDYM0A0Gq",
-	"HXSTaG3hV7z0n/tAKro5jNvh1mOno0fO3/Offd7yVtnzxVKwBZP2a/qmtn7zATbgu2LLZ/6p6Mia0SqG",
-	"Ta1aEsFu2E4VPQIxftCtxDWADfzYcx8HDl09RavnMjqwnkUJb7FYShRb0Q46QZG+qOvTl2d5td+N4y6I",
-	"vcBvN/KFD5iQ4s45Z0WpFYZepxg7D6ZOV308aR0EVJHjOjACWEU+ylaIj9j5VBp2A1zRkTsveshN7Dio",
-	"IzjFN7BM3e1uKrOBLdTNxqCM0jZ94YrbOZdhiG5Xq1qNpH04gMA7LUNXPDgD2MqPcSf1Hp3KWtOmATvO",
-	"TSKJ7HvAh+AtvPjL8d7r52A2vvu9cB7FwrftenjqHHwHlmc0aPot0A1YFn8FfctW0UriTNQmXC8NgGn4",
-	"22TXIsMQBaSFhywZrFYgN1S0zACABDVI/J5lPLnVZRQMhDbUJ80KEZgqvX+D+spH+Muc6i1z7oCqp2l5",
-	"DNaVG8f9WFY8wcR+V/x78i7kqRU5Z+pXdy+8644Ol5BQyjCxzqPtvoBo6kSlFhQAWcSaVNQEZBm/BI1a",
-	"MEg7GpMXkKrnzFH3VMB49mUjUxnz2YJ9+WdrLFl7nGjCFku7xl7xLNOM1u7j5moFmYTh9MZSJT8l+X1e",
-	"ad5wSQVAXZMf8PRy/+t0g1oojIIsu5XPVp5K+POKhiqo+I4fo/FL/f0idg6f0S6VJJJ9sjDKgCkO+FXu",
-	"eIYyKiiUaWWtNgtn/NAZNVys3a1CMLynwMf9s+XVdXgmtAwQwZgfGOqTweJROgABeongp/TavL67h05v",
-	"V8Kn+vuG3PP9HUME/UJ+jQ13DBH0C03lcMfQe/ehD+wVgjEc7RJyvXz3Bx2j89wK1kPpaab2rslJOkTf",
-	"w8c+tOLDII7XfNfNd9U/QvVvYs5pP+srPZ9bX1Ap4EsHPETxgq6J1bxpmCbg8ZjKDAoiIKJJZfkVrzz4",
-	"gGQrI5j1Gc+5N6XzWqg0xNJeAAeM1GRYqaiuLALJuGuZ5Jjg6+6ROA5ieM0Iu7pilTX7rzEpIfch1kt6",
-	"+/dcJK+9mbIcrCEEw7vTpJS3kv48KFd+QMw+f+clwGcel1jY/YITFXIu2MNZg3CIIvKouiILZ6UuBesK",
-	"G41Ww2UjctLLTaolxJtCZAOkNcx7IeevEuYO1+DwxBdPJZpD4PjEVJfp2W9UXyPikgHDDZBg9yodftBv",
-	"VK6H5ZMXe7o9VpFSX1/3bP1iCrW1e7gzMP0zZDHu0LqXCSEaUPC96mG9Vd7PuIesB5wkqYujYFwLY7kn",
-	"TXlCWqKWTNIlH/9plDyCBCpU4R0ggfr75e9v97E+RU/PnNrA+UTqtaQL7zATitZoTJff2iWjcj2qmpEG",
-	"r88IxVzCeb1csuowDxRdLoV/2eRG1mNF+djP37+5+fuvG6YNV/K//zb+j/G/F8mi1OxPVtkHIIsqCqpM",
-	"GIU4OUL5Z3ZW8anK24jKWHQ+xjSB81d5wbRlQpC1atFReM1l7c4daMYRc4nK2ic9WkWuOHh14ZatGZme",
-	"+Vdwg/ddw53l4JUM+DhG8HrvZBFtMyavIRVzKTgzCYujcbckN46M6cg9HquCQ4hwKn2MMD34k0fwAXZB",
-	"jFU2bKth8Na4P5ZU7Z0y9o2f2GIZyOa682Af56/cxIBI2I5qPR5QVLlm9dlPVrdsUBXhoFvZxned5KUM",
-	"1L6zBHpBRb3Q1Zwn5nLMIs5JOopKMLCG6xuBVgmi2HkvfocWcB4JiHdfAPMpTvrAC8n2pN/xIpK9+3bo",
-	"6jphk3bPwppoRiskJ9yD1gQPud01gTUV5XvhnrsfxKIBEo5vHyzj0MMTlfLkM/zszbIUxe59vwcEfx8A",
-	"dqMeDLS0+pa2YBCnx7XqT6QfWhTEhX95KKCGQ038uC+Vtj+v79zsQgn2GmqG7tz074rLC3eY3bnlOSIJ",
-	"x+EOu8AlsZymugYV7WpsfyQ2LOL2mNEBb222LjJE3jPO2jEC+5ZqrPvKeIL0YCCR3cfMH4FFrBuhDKKn",
-	"Zg8++S6NeB1ePPAsuoN2PIUjJslztB/xKQoUzhj8lzOzukhQAQjzoHQGAave/TC577Wej//0BV6877/+",
-	"cktyiF3wza7HPvsrl81BqLbQRwA0TaBTgKcX+jkgPS6bk16yOP5v9ZzWbKm0PYAG5x8akwvWtILqSPdo",
-	"GEMIs8QwGp/9zT+z4nY+lR89+enFL+9+v3h/+TGjP0X3r2EYI0/4ldlb4X8wRXcWwFh9JoWnDf15Hbkq",
-	"Ay6AWjLkKaVVhNNKvU7lhY+UhGCrrkOnCwUfXTEJ7NKYjV/yGOPIvlasHt/WidL3bfS/XNbHWCDpQx8D",
-	"1ldQ2j4oa2zlRY4hLF87rDTyQ91wJSJTuFOJqGkAkdpQLo0F+NAQGHHNnvuQVVaMnMDApzKsjpwc+v2c",
-	"xS78eLjJjlEfzshYQNcBDbPmFZC9ki44Jjz/kdcfPS27ZlfwUrVbUYdjxXXa3w7XoC5e3IlFaJPaZTvn",
-	"5DP+z4GofUSYwqc9jXSLd+a8hA8KfAge5trtfRAzMphuuW8XtSow4WY8uDE1TUW6/alE+lpA7sVfr5Su",
-	"zYjojd090Ui7Btt7PCioYGR6Bjj71CptpmfQLNtyR+Gb3Jc65RU3LNuFd6jqwGgANj7KW9x5/xGq/jBV",
-	"dX873Oi10jNe10w+7EVkYzUpwXrgssNjIbDLdab/BW/mhYquzAFCVLnD7f6+Woke8KDuUgI83BEnOzO4",
-	"0ieTRlNpSyRWbvRH7Pap9e3QuTthTrIgo6iXk8/uRz8GsiC6skwGRpZd028grJEWxyE+jsRSDzST1hze",
-	"CYYYqX3m/fBSOFWPULZX7a8ERXE8M4Raq/mstWyHDIae6ltiGLChHXWiPwEput0slNPtCbKExGNA5KON",
-	"CUlThpeyZd7T5vhg4aCF5d98z8cz/ExzNflsafNB0sWB2BTySGFaI50Bp7CbvOJ8DdmHPFTeMRsRvvmh",
-	"0dHz+cXswLuoI7YozCr84ZFGrXFw5ryRSrN3XEpW76Im2KYEqDRDZrDACtAaph8VJcChLwg3WGdAYo1r",
-	"aej+T/0G7pf++SvTa9QvqWWN0utL0TYRbHLoKoqadpJnQVhzPR1nPgs3S7dNZkjlZ3XXahxufXTa3w6X",
-	"0glbIElO2U45+Yz/82FB9XXPrFcvwR55rzhnA+0TbPwb1ddP3kbJl9Dd7gM+pd3XmjqbBcq2RwQ/bYRA",
-	"IBwYyKey0rjxZyyT6TQMPJOGbKXCl1xqKJ5BF49NwX4tioI05Kcdlks1IAf0JitmKIr9bMcuf4cU7dRT",
-	"SX0G2m7lrWHQkXCMBZf38FSPhImvqdmNHdE53aEiwivSbuFfsKVYx8P8AWSfD2CoOz50cJoGvJcqSt5X",
-	"se2pBmTEP0Nku5gxTbisRFt7xC4MQ7nNhC9YOEs0E4waRmYtFzXEMNOZY+ZKQwqAZibV7mG7X7kFPk5u",
-	"yZya+Y76vX/4IR8s4bPsk50sBeWyWJ5nrOayeYDyvJAw4y5QK6rTBOOIxoVKvW5vn89mWq0M065nd4ZS",
-	"4O38cM3gXW5dGBjLrjqz/3n//l2GVZkSdkJJJcE2MwZFmwtn2CV4oo8TuuSTj2RJ7RydpnIdQs2GqNYC",
-	"CIWX6cwpAjwZQc1mjFTqJmRHlOs7oUgwsHyGInT2ack0h5JPQa4Yta324ZulaBseSBJaLc5+OnODhC3C",
-	"z2UZ+EZsE6NyaSyVFap1K71l4hYu0So4I72hCfLZtltf1AsuubE6fUyl5BVvWv8bw6wFDLvM3HZtCn1d",
-	"QIwK4OuyUA1MOzN2ziyv8m7QP1cYUsqkcwOInJjjrsFfaPmHYTpkcnUe978qvSzkfckbbhM+RaheTL8t",
-	"tP3lBkGnN7AtImliXta83fplSKCQNaZkhNBwNkM+Urjd+F0nIzxvE9OcthvhqRQMWN5pln5ZaPi7bqjk",
-	"hnoK3Ig1VnNTtRiEx9uZ+xbBZ5rqdWKUzD0dBQHINckQaSBnNss6eYcZSagC+WdCIch2d6+Vbhe5wyy8",
-	"3d8iClOZ3ysz4tZ0L0jSEOX5ec0FI+1SKFrjHNRqJeFfuRICYVORl/OaGaD994vn4FQir/cO/QdWRUjQ",
-	"EYJVOKu+rnl/r1mDkoOrwNEIO2ZIBAIC1C5naLEfVXEqMgrr/LPk9b6V0mi6nJMf4EtGOPwREpb/6Pbl",
-	"vCu3TcLjO5etO2TrVnDZjHDx+/15QSVtAAAq6465Jgb26E/P3aEM53hFqzn7EE7XD3NGa5/d/9L95bkb",
-	"t1Zi17Hsn590H74dnf3ynjaHGsEzt6OzN9TY59H8O9Co+/Dt7e3tvwIAAP//8W3OldzuAgA=",
+	"H4sIAAAAAAAC/+z9/3MbN7Iojv4r+PC+qiT3UlLi7O7d61efelexnaxO/EVHkrN16jAlgzMgidUQYACM",
+	"ZG7K//ur7gYwMxzMcEhRtuXkl8TiAI0G0Gg0+uvvo0wvV1oJ5ezo6e+jheC5MPjPZzxbiKNnWjmjC/jB",
+	"Zgux5PAvt16J0dORdUaq+ejDh/HoxRWfb2vzklt39ErnciZF3mw802bJ3ejp6OLHZ9999+T70bjV/8N4",
+	"tOKGL4Xz+J1mmbD2Z7E+e34OH+C3XNjMyJWTWo2e+hbsRqzZ2fPj0Xgk4dcVd4vReKT4EuBzbHN9I9bX",
+	"Mh+NR0b8VkoD+DlTinENx/+PEbPR09H/OKlW7IS+2pOzXCgH8zI409Ms06Vy/+AqL0Q3ctCGLbARYCfe",
+	"8+WqwEnr0i2ygt/ZTqSh7zX13RvrBpptxP+zFGZ9EOx/A0g96N8T3T4CQCz7dh8xOfjWnz0fsno1vDqW",
+	"CBHbDxFrRc/KwNeedYHP21alfcIR6mu+JNJpj3q1ECwrpFDuaGX0rcxFzmayEAyGZTNtmFsIhoN3LQw0",
+	"x38OwOScu8V95l8ba6dVKHPpXtyKPsKEJkxAG3b2vAMHaHONbQ5JnxG5K7kUF1zNuzarjqOTS8EMNGZh",
+	"P1Kbgy1G4xRjl1b//W/ffncklRPmlhcJDt9Abr0SP8rCCTMEu/VKAB05YSJ64v2q0LkIa5XCFrrZBrbS",
+	"iaXdyoYaSI4+xIlwY/ga5/GMOzHXZn1ZlPOX0rqOOYRmzBbl3DKnwySm62P2qiycXBWCSWUdV5mwTM+Y",
+	"W0jL4j3IMq7YVExUaUXe6M+WXK1ZRgNIYY/Z2Ywp7Vg4d2OmQnOp5uxOFgVC4qtVIUXOuMoZLwrmFkbw",
+	"3IYGzAhXGiVyBHj6+r8IKRHhsltelMJOlLQMjpjT+Fm855mjb9BjMlJlUUxG8E0xrYo1K1XAFudSG3ai",
+	"GuP+E7pUmAPXSPYdI/7aLYSJSIVZyLnSBhYBhwYECbVMK8elArgRxdAn08rKXBiRH09UxwGoFnww296k",
+	"lRYBdbCQt0r+BhgHGnp78RLpqIOjhXbX0GZHhvZMF4XIYNx/cHvmxLLvbsPtsSuRoZg3puWTKivKXDDO",
+	"ZlIUOZMKF90Iu9LKAo3nMuMOKXEhYMsmShskWGgXwTE4oQyOgBEWjr4HlEUMj9kVHBHLb4Vla11OlBIi",
+	"B8BOsyW/EczdaeQSUuCRyxYiu2FyxriK0KVivA6zc78X3F5Dp30v6WplX3Fz07GiLyQsyNOJOmJwgZZ+",
+	"42NXuMbg4ymjPQtHEngvm5Tffvt9JnP8vziiP4EG6IeJ6iCXCP16yc3N3rcPTMvPVDmh3Euh5m7RnuMP",
+	"Ol/j6YNNLbAR7MJ07YSNFE2PkwpJD/PIAx1A1FI5MUcQ74/m+qj69W9/QSyfc8fnhq8Wp6Vb6Oru4UWh",
+	"714sV279C/CJAL85h9iZ6IgjCLqQPNeywnmWAy2sbyJyYNhuISaqIvSlWE6FSfFdpG+Eymy5WmnjLBNI",
+	"IsxLjRN19twybbx0bpFHRo5J1DzgfiTsOm7IDS6RuATjcgRmdq/VjHyudz2tlXNFV+HGembNu7a1rEMX",
+	"pYPDD5Ic6oe/b8F+liq/12LdSJX7hRo2K+iw+3ziqHAnANLJab1Yclmc5rkR1nYLxIoJaMc4NWRnz2E3",
+	"dSa5Ezm7k27hb4zfSmHxovDE3nHfIbRrD+2A8jMKfjsz6yDpHyd/x2v70BycHg6HYd5nmVaX8t+iPV34",
+	"wqz8t7BNHcBfv3vy/q/fPUmjJjOtrqFTL2ZClcvR0/+ugfr+yfvv4f/f/f3b99/9/Vv415Nv33/3BP/1",
+	"t//9/ru//W/411+fvP/ur09Gv6ZeGGfqVjoOyJ897xesZGzZ/Uys2hyQwuoo9glavXhunO9NRPdC7KVU",
+	"N9sF0kKqG3bZLYjC932E0Nc6F88WssiNUJfauA4s4HCRjPm1vxSlYgQXbkSp4KWyEsat/a/fwGVhtXHw",
+	"6uoW7P3I19BytB3TbdSldC666Qq+HpCiACF4WvyIj/EOxKABo+f6OMoTzBkhQCQ3ggmehbuYXkkWhGS/",
+	"Lgz5PdNmomYFd75L/ErXs+8HkvbZc+YW3DEjZsIIfN26hZAG3rZCue6NIAwbO5CLGS8LN3o6AmxH48g5",
+	"/J+AUJobwMIAqSJdDdiwHrLGLQOyvsZJH3Lrtp+5wcgdDi34IxvESFWtbR/JV60OSvoV2EvHXWk7dDH1",
+	"hsxiyy5mSl8Hc9E2CvGZ/waeGeekOTFpXibjfFCK54phpydB4WKYLbMF45ZNRu5OOifMZNS8i/3P6XXX",
+	"IOVfB2A78uRzPpcKJ9axqlUD/2KpTDhdq7vi823K3XPkEV7B3THyj6AWWhWa49tfiTt2K4yVWqEajSsm",
+	"3ksvR1p8RqCyqqldc3qiokIaWFbQdeH49LN/jy1L6+A9QawNlGdKO1R3kAr5eKKw3UxwVxoBSgbU2cGe",
+	"WulKXCPr2eZal+yOK1SeGbEqeIaAcbyJksBOoTufk8JKvHdjNi2BmSJ7BRS1kbDyBUnOnN3xNUHz7JZJ",
+	"N1H4LCSEbCQjkUvHp4U4yYxereBfTC75XOCDEpX1fiHZQlqnTc+lSet0XTMmbN/V/0TxHrjK4MfP2QwW",
+	"WkPTo3LFfvMQxvW9Cj/2yEge29ByAMLaum3Mb6Vtj5kBvh6Q2Z0bDRtkUYIU+Q/rrlPp25HsSOoHOp5f",
+	"84Zdz34z7Pno4VxP13uo05tWvcQDMqD7H1qqPoNFnNa/tFQDrBXQTOT3MFeEAS900W+siJgZXexjqYBu",
+	"h9c3BKxAmt5OK15E7l3RAcLxheDZ1lNjoFH3scHPBzw3F2KlzQCkoFUfVvD94Gg1lEFNxKgBc9zMhSOl",
+	"D9FWF4trqXlS+wMwe0UlPyyJQVtG3FFWqo/u0aGFvBTcZIvdlGLUJ6hKcYpdaP62o+ADJ76TXuBjp1UX",
+	"jvIBaeQjrEvfOlzxOZj7o42z61HON82bHeM5Ph9OLLXB68h044BuBh2n1/H59R62/is8e+GV1nFgzmak",
+	"qcaXIT7WKgX0Ut9GfXU4ydAiGmyrnhPV09Vo3fNqJsDX0H/bjqLxtEfBSQ2CAhMk3ZUwS67QGheJs2uV",
+	"sfP9tJIVhjWE7RladM+lUqKLXQaTAS4ZDMhW2Lxl4/bW4ZqVsiycxbmiVYF+iM21Qaswg7kbUay9NYct",
+	"QfYzIoOVATF+fcx+WDOvrRjD00JaeAj4Xaa1TGDEVyvBDeNkC3V6FdXD0lg3UfBi6t56msw1AU5t/lTr",
+	"QnBFi2mEeC5WnW4z9SXkINxKJ2+98ZzkbSLRTftu0wgMJv36WShXgYore00OWFRmHmjwb2H0mDwG5Czs",
+	"BIrrATRopLxmLVj2OR2nlsFnTJ4Bd9KKiaK2enVUiFtRsK/hMH2zcVCblqLUSiPKW47XL9LKqSyk62KV",
+	"JEdGUyg+3/yqZOw29qYlt8fstXaCpjmt0xbOaFVOC2kX3mxuGTctP4qvcsNn7isgw5rNHnpPFH6yTN+p",
+	"aKFM2F8Qql//CNWIWynuAGzNBjeuQ6B1nYHJR87qH+qgcy3oeCz4raC3uBKZsJaDKkGYpbT4EnWawXhM",
+	"qiMamSY82KhXrevuQna1owkh+wMxOWHdDzqXoum3+swI7tCm4ncb/on+N6QsOvmX1arpJ7vlIeX9YZV0",
+	"khfnRq9AiKp5JQZT3CHHjHC7h70U7vSWO256xtWZE+7IOiPoVCTeZFOpOO5ayzW4GurtKj/wmgLUVyWq",
+	"RBpTy5dSXQoH9GoPPWoddmpsa4V7i8qth1rRTZmRRvMKrWOgdNBC4r4fbtoBYoqSwrdzbu2dNvnhRw2Q",
+	"h4x+IaxwD4cCgd8Y+xdh5Gx9+EEJ7uZ0H2Sdz7k0iTEOzQhroDs28+H2sQG5a9hD84sa6AS7+EHwTKuN",
+	"0UBrfLIquNxhHAJUBx1cew68gwFsYvfCp+eiEA8wIoFNDXjgPQtgE/vVHPEchWytDj5yAJzCIHr/HXpj",
+	"I+DU1saPh17rysuyPVd06DnwNMn5vz1D/P2cGyczueIHl1Y2wXfN9iGGTYxVObIceHkrwIk1Bi+VA48H",
+	"IBMjvdK5MAjv9CHOyib4BAboE3PYUdF5JT3ST0IBQuJZNc7BhtyAfUGPpsTgoEp8kJEBcM+w0hXiYcYF",
+	"yO2BD3xGAWTiiFYjHfyaAdA9V0xtZPLH8q/jg4ztQa6HjLu+jCAHjz1IMdCE30SlrSjY8FU5+PZXoJOL",
+	"sjnyK67WDzI6aOv95Gjshg/MM14UU57dHGxohB6h0ojnC63CiXuGmqFDkd0G4PoS47fLcrqUDzBmBbcx",
+	"pLYOza2H1PiQ/XbjgtjUFpzmoCpAM61Xz6Gy2B2PPFoHJm8AuUnWmzjRPekRQb0qRvmQph0RuwAd/YGv",
+	"U4S5bbkiamglGLO7hQRXS7sFWW3c4bEFQ3j7+qcPB941AppgR2BAPfTMwGCbmJcuDn3TAsjEnMhMdeBZ",
+	"EdDEvOjDgWfmDW/tuVUq8AOPWAGGUQFAfdh/iilwd/WK3whQiZqDyi/nYDzJSE2PZk1eJMatfXzogdGW",
+	"QPa0lB3hzc8PYEmwthR5imW9+XlESndqCLf6QyAAcC/Q2tqLhC6Vq4sRh0cnjPBKuIXO7VZsULNKp+Hw",
+	"iNQjqrZi8lOH8QWdOk9Wan5v28Cbn0fj3sQxqSn59ifNxrVMMn2dsE0qo0xfp2bjutHoJ/EA1PJFrtRD",
+	"UXQPFYMt7KH4zBswbe/GbOqmuQPTTR10p6yYQOPwm7ILJtaK5AH6nyf/896c5QoN/neY24C89sml36eN",
+	"OX60p6ky4B5y26y3Gu68jI3UKyROHBSxCHsbMcWGBz5aEe6QsQ8tPTQAb2Uw9xNjVg1l2tJrGrYZD0HV",
+	"DIOHMCA7yOJYw3L04UPdne+/a5DGhEUVf6en/xLZlhW4LJEpH3QXItQhN/OlcEfPtL6Roj+rHdpXeR70",
+	"t+28FjwPjk2jlr30gNMLgLuXtWnh/CRDH/ZQbxn3kV4NYVYHZkJ1sNtYUNP+/HEpJVpqT/McVPWHHD3C",
+	"/qd0mLIirYyLzaITJs/BtbGFH2gdP1v8Ds9hIuhtWJH8sIHPgc/+zmslFcmf8G9wtPZobGB57xu3yptk",
+	"h88heYPWIQ25PGtzLaTdnNiFgGiBz/pEEYqf9aE6PEcceqhKHJnwqZJU2Zs3P6f8ujD3S9L1c+uTywcH",
+	"+ZiH5nivuMsW4pBSWRN098XUhxV9ewikCPJOWNVeUAfEqPPpgh88x63GPyyv3TL4XLhq5ANLLQNeTYRE",
+	"5Hg1/6aPtwR0OHH8H7WZyjwXKpmRwH/6MB79JNyZmukD4gjgugWrM+WEUby4FOZWmBfGaHO4p9X5GQFM",
+	"jB7GZTQw8w3bzmEHXYkAum89QpvDHpbdxj7wcWkC3ibmv5Q3eNv+JO4n8hTyRmwPRXdiCQMmRR2CMETI",
+	"OS0Khq0pGUrlVICToVD1w26oBxpw717Ul4gWRmJxxao8CpbN5a1Qx6OGb+IBMQSgFyGvRxozdcOkysV7",
+	"kQcsDrtIALFz5Jw7Hmd/YIoPIPu2Rd1U18NrXXNe3EwAFES/kXcTO81zTAx1QHxfo6KtjSX87sODSe5k",
+	"FxinZ0P+EgwJHjVcPj8aWrX3HPywlwKpyTJyjPPjwV4/ALUBvAGRzRG5CtkNv9IDr1nLa7WLCmkhqRWb",
+	"+15tLMEH9YFQJPfWXvwcROn3ICddIR4KO3KC7UcP2iTxO/S2wlMxpBrsRKdTofBIFY8hSeCB17KfO+NK",
+	"1rhzLkgL8An4rsGBt3Deg78sBpNbVAA8YvLa9Pe+1x3S/GuII3aXoSqA+XXoJVP1aehlulzLP/I0adCD",
+	"TTbm8KRxNmbsftSlypPpFNkMP1Gzs+WqEEuhnOhoLGsNqEud2Nrtl+Hroz0PTZ/4g/KUJuhtD8G09/9n",
+	"hdADIdONAjyMX+rsATQEdcip8eE7K3wDZoQzUkCeH0vG61lZFOvoRx/c+w+IH4LsRCz69Ffq8cqf/8Cr",
+	"1ImEZ0GJJaHH+o+YC1KYAztokWPu5hjbiLnRXqr5g+Mk1XwgTg+IypdllI9KIPtgCzaEL9YCVA564FfF",
+	"Ou02hpm+MCglaAHaZ64eiHJYrLTpXwttDm1vqIAO2IoYEPMxZx0jYw45qC5E/5CHZRTbxzv0tuph5+uK",
+	"H5g7I/vpGe3A8/QQt06zFop0yNERbA8jqSsS6aefDph8pW/4DW3NVJcuRtOh8kY6i7YE+2jf1zT9QxNU",
+	"BNqnYLcOvYqrYoGPfBEPztW3noz6m/qtorpb0qaevvHrv+mdHGLRIMonhMAd0j8lhqB5D9c3iMjBXWjD",
+	"NELwdBz2gHMJY9Tj6xDOg8zpQ0gkiP2iibxd+IrV/g6lCaCpz6mI6RDZolxyeAzyHBPyL4XF7P/Aurha",
+	"Qx7MAqWzpXA8546zmdHLRrpFbFoV1LLC3MpM+BSJTSWTSGNKbNSb87HNGHMzwm8q97UMhMqPSisMy6Vd",
+	"FRwT/bZKxXn0U4uBEz1qTXSfMWglkGbyXMIIFCMbJppKjXyq1qxqXS1nWN9QzRRmfzxqqdDGI1vO58Im",
+	"tVynLH5k/hEdKh7DbI6TKebr2jval18To8YQJp8D+s1s9PS/t5xsvVxqVVuPD+OBMZk+hqMXj0ZIckuL",
+	"Kd6vpBH2mruODLOwJrwq3u7bjyFTKBRlHTPpmBLgT+I/weLF+CLgpUdOYi7nFl1Qxs8UbcOXkFm3GjxJ",
+	"XDbTK2EHR7FeQvOkQhax6V9JCsEdvK+x4/ANvRSZEQ53tFWUr7YLEjGBI1D5N4xZSGIMqwaPwnoPms5E",
+	"VZwMWlkcztdNkZYS9Yr3K20F3ITBg9izQ+gBsLjKJ6rq7uv1SuvpwDptqDimYBkvCmFCfalMyFt0zJC2",
+	"QsiG1MQSuAwcQyuyEpM3A6Qmqn4saAVcwMBxJb7ZvW242zuUwIh7tpEYZgOkF8NaJ+pGrO1OQdUtSkQI",
+	"vZTYdZgVcOo8lVB6/OdJb570cVyt3pVGKMQoQw2nZbZ66rQu7FMvY6eQTaCRKN4A7TNnN5feX22vnp2j",
+	"cCDMMbvSusDTRKmjC7mUdAnSSYQp2RXPhGVzw5UvJDOp4Uq1KmM7X7ByxGhVx6Hm0ERN2hOcjKDgUP3D",
+	"/5yM6P6/hcIFEeoYZRvoI9Uck7Pr0gBSfvlppgQofnwK7QMmx+wUl4CSbSNuE5VxBdLNVFChploFUnrv",
+	"wL9Pz88wT79eSgfTB+Sg+MI4sC1kNQDG+GWP+cN34gtEEH3MwfPw1um08ff2MaBvOPNQPx4eF0I5mXEn",
+	"qinDNCdqon4Wa6KElREz+T6sCqfSI1Xy/zGbjGy+4jeTEVWcwuIPnE3UpdNmnQvFzoWxKGLRDNjPxOKx",
+	"47TVMXSbqB+0q3Uhfg8FvQEDwi2IpCZbcDUXKEYu9B3yELeALcl1zCnOpmLBb6UuDS9YLmexGCHgIi1b",
+	"CrwTOGReL3nBslKEpOLhZOJEr/l30yfZ9/lfsln27bf5X578nyn/+1++m/2fvzz5a/a3J7O/P/n+L999",
+	"//fvpluFPL9hHfwB7tyHlfFghKpft5zXTIiRLOhbIya4zJfYElYV5QcsHCCVdVxlwj98mj0mKta42ywF",
+	"XEkgx+ytFXS7Ox1eBIyjSP2V9eNMVBIXyywyvTXL4NWVSwcnmBxBmHSpt5HXYfVdaJLqeof53nHgRHNp",
+	"HRaJ8Gy2Vrx42G0m8y0vMl+QAwtrShtGX3B7nAYXDmsarHjvwVYN2dduIU0OfjFuDeNow3IBr0h29vyb",
+	"3W7gVTj+eBWjg2xYGUI8ifSqVilxaHRy64Bh7Z3aNo7D1VxbktpQg8h/V2mv2buDsTcbJSQvou2dhyPx",
+	"ZDzit1wWwB7vHeztEamD7Fm2H6ROE4WR2eII4pfYVOpQ7dIflK8sVbPI2IrsZc0SlyRoTHW+9jIG/r2i",
+	"PxZyzJYkMEBBCvx0sko0tLp0i6zgd8lGJxX4DuFrk3e2dyxfUr7ttqQ8lXrrPlTrB6J1vcC5sHukDgqE",
+	"QLX+dq7TVyv6N8yHvuakPg7F97b0fCWWU2H+A9s+x5yRYywgbQcO+cKzseAnHjRD28f12qMaFxuwOFB+",
+	"CrvUfEzsLg4pzygZzthX/Bs2ajBvkf7JrlBTNmxlL0PzsLi3wqA+/NoXbhuGwS++V61wW50/xEKLntIi",
+	"yw11DYH4w8b6DWqj0ib5sT9Qv7YrnECLxFu1DmBr0FcdVLyBh9dbDPgn6oHRkwSxYR4bFprDPQhFpepV",
+	"dzwT/P+Nxi3OkbrdmtOsYdLDlVuMIVXFzC1qIpvEgt4zOS+9XANCdWkFaKT93GJxWWTmIBRBgXBnuLKk",
+	"AeXFSfCKz/RyWapwaLxiiepfFXd8bWFR8HG12wOqnS+t87Jt1x45JAFtbFQTUs/G/CNy5/aN6WW+/+vL",
+	"tQYpupItqxvyMt5trctrPHp/NNdHXTdaI+Fja0V2vrf2vm2cMMI6u1NVwEdwW3zo3vrXnfJzCC8DLmFs",
+	"fPaE+obVtv/AjeLTNftZCNUntsAdMvxhia0HPiYvdKCdvqdkvMN2lKI9Jl1H+kJ3Ey7PUyaoN0pQld4l",
+	"XwPLyYWVc4UvT24ZZ9gtGm7iIxSYY2nEGOtq24Uuixx708aIHMTWpYQpFGumSe/pJVmGtj4qRxfqeduG",
+	"frkmJvoKb0mqMAIVIKAOmZaycEdS4VTsU1KZaeUthnBpegbrQbNZweeorLLCUUE26asVo4Y+qkv9+BsD",
+	"pLHd4Hi04NUUeqhhQ55ALXO5BCBKK1G70a6RjY5+TRF2ZxGtxEMqg8rfmS50aRLm3PGoqT643jWvVs1+",
+	"vc3p9VkVg9jY4N/7TZxD2dNvpcxuroMXr01ZKQvv0CGW+l+SZQtueOaAy9iFvlNwChBI5QqssW8s+vgW",
+	"lIVvwKgTRRpQxthKn/js4sXp1Yvrixenz67O3ryuV/IDRQzP8wjcbtz2rUXYPPjBsL1TjsNL6lQl5w/V",
+	"Htq6ujbJtpMlthY1qj1ReCqKKmoMz6K0jgp9MOvhHI/GH51G+YpjruUBoSZnXgZ8Fvqsw3X5J6V/MZRe",
+	"593UrLlR1WaPN6gzTYvtLfl123FqYNvObpitthLEs3MPI4BEcoqVdYbX4GmBSfICeyPM6bS04jQL4MPN",
+	"VWiQtN3CaEdrOS10dpO+uiKYi7IQdSBGrEhxSaVScRfCP69vdVHis5qXTi+xWVZI2KD0IDZlMAKJJMiw",
+	"rT4LIecLV/ukStAiDHsd44Bnz3ED5FJcE4jEKBR4OTDb6ZgqTCel5NPzMwZfo63XUjFtjc6gNhYdRohf",
+	"WfbTiyv27gRb2XcNmaZC7k7mNNzGCqTe4XEtx6EMdjXxACkuavIw+CVL8C7/9Kup50kmJdcGNHI2XwJZ",
+	"9tdC5U/sd/Yvf/vrE5678q/f1q0P7xHlgS9DwssOl9arvW9J6vBpN9E/7HwS1CXOfXeA1O/txcstkKFF",
+	"0toFTRitPGbaXegi91Z2r+Kh57mezY5WBXew8mwpcsl931j2A62TGh3FtKqZP6Pu5ZidOXygGLEywmK6",
+	"svrQXnceveZyfaewfiv9vjEc+c4wUVhxB6+IpO3l1DlhfcIerW7FGvA4N1Gl21qShXMr+/Tk5O7u7vju",
+	"+2Nt5idXFyd3Ygp3gzp6cvI/gKkd8QruUYaAyb7qGV4uDZwF+MEJszLSoqlGxd/xQZDkb1XO3+HOU5uJ",
+	"isdD21+tV72v3Ngwmgnw6jwvzVzkbS7s35XXu+ocQx3uXWvLIR5N1GBGQaoLrHr4WrQlCGJ6tYkNWqfL",
+	"uj77MGv06SbzVtkvYzpRPnkunMg6vF2DXMKQP/m7FrsyZ+R8juZw4EYAiOUICZqbshDRO9BXsjci0wY4",
+	"GcCYSWMdc3KJJZNK0lFUIL3O3IK5b4lWDtQ6zwEddnYeubGvqgRta3rsTWzupMr1XcoVgGdDJMiWSAiP",
+	"JJTK6mrktuxSQ9RLL9SLivOzwPaB0+PM0mZ+UxZiOIYobR6UpHD8cViqfqra7cqO3ZLXdSrl+uBb4JzP",
+	"JWr8fEe4BZpbj/k17W6J31Mvhl+bi+bB9i9Ttxtmtr81dmemI5fCOr5cNQo79DqwHICoKgm1jsGvjeWh",
+	"iBrKUZXgsuRc+AmZbIVA/zbTPDA2t3MyGJn7CecSx98yFT9gkOn8EvhUXdWaELjq53AfVZdm9VupUr96",
+	"hcT1isSq8YgDb7tGnn6dh7uqX1qsJDngyxL48lIq7igkZslXK0k1kzoG3bqiSQkwNduhkC63rM9QOG+b",
+	"a5pauu2g2qLBeHPDt9Nc4wx30MdAKI0T9CHe4WuyrREhfBiPtBI7vQ+aKH4Y79ZvA6mhnVv7vXPX+hbv",
+	"3LlJsTt0b9NExyPJLYba4Hd13tjL6pwy2Y96MT/n1t5pk38uMxiPVh6j7TpewqrWY9BML0RSjbjXFJ2+",
+	"Eeq6NEUb3m+lMOu0pIyfwCuVL4XzLuOo0gju+gJeHzeoPqmijvhEzQwSdO4la2ZXIpMzmVG8T4f+z2PX",
+	"RgP0Lk77qE0RTARhMT0euCweibcXL7+yqOeZqGVpQaHjssXme6Sl+/nKsjsxrdyGOnHd2F5AfOzXsb2z",
+	"HbRQ7UgvMaC9titAKPOGqEoV+b+f/P2vf3uSWt09yKYD8yxddIiQfqXzhkQS/dLiGVh0q5Xc4pxL055n",
+	"06W6mq3OZZKScG2bTePR27aZDV9lAtQ112Esqc4m2vh89+T7rShtZRsBkX5bvBJ3aRz+8te/pVZRF/fA",
+	"GTqPcchtSCObOxDKceP7kaNmW9CrecRvZuVWN2lGtVivhIHPwK4MyAJmWyBynyv/RsR2PbYuONFvdeZv",
+	"Q7VFOR8Kq6P0WHAz3bZ2O+odqo5pzUNVZizBIbbvuuw+QJVBFRzVlJVa2Wd4dZ2pVensbqHu2/Xzucxc",
+	"LmZHTWOuiGPTtSlx7I5w2KqnNqfO8WyxTCbfHmYs2EBGGx5BNowGwbqCgR7a2mhu6eToEeKFr7C8D4oN",
+	"1EKp5pTisDJ5vKGl2uLOoc1z7/zQakV7AJ//4/LN62QT8l8rTdrYis64K21c05i31fsAOEXlmtpP0xtI",
+	"/rqNUi5FLGIlnTCS77MbCerVxgbImYec2p5uot3GGVLdqrW4EBbvbZ+noe3cZ5oN+hOFxaYXBD0MBhtD",
+	"/nPZIBeHtxvtG+A2NrJraZqop/b3B8GzWljMpq1gip9RLmcFmMPv0CjOon3Rpx0ggBSuineW4dkNhv2u",
+	"SrPSVlg0jWZaOS6Vzy2AsbhSUaans+fhRiFY1Ytgqa0r1hPVAo6xxQxOrPDKeMpyxH4oXXATjZ2W2ggM",
+	"lj1j3g00KzhIx5QsBQZeasOLYh0NJHhvIoJ6xiajOKdRyurQGQe46QgQJtjIXeJBJy/km8F1kaCYx89S",
+	"5e0kAhhG1yaALj+CWBDw4UJawxCNmNaBfU7jZZpWWCTatQVr9HTzYbseglROzBNOI1XbvtF6I8xCtuZd",
+	"6kGS316nX2Gmb4W5xnLxgz0zhjj0HdqrPkwpBGENcyNq6sZB7Bw6ziW0hT7aDNlc7wiEI7Qd6bzfHMIa",
+	"V7vYRwekP+zyjdO34trpXWa/gW+A0IdC/5tyGE1dozfKzlaMPw6FpekoSUB9e7XTMyd0Skl+iVKy7a2n",
+	"NgNciZuMaFNwrMD0Ta1fo7AHGQ67i16XBQY71ze4lUOJkstB6ggYi+FY3gErcWX7CWMuGuXB0/PtMyH5",
+	"vci3c+PSAU6ncRm+sqiROJrxDOSwEN7UKUeca4sX8SZBNOGfV6riGeZ7WPlulGsvDB5UuAspDDfZYn3M",
+	"KDMk/DpRvjxIaaHXO/rrHeaiOWkAZXyp1ZxBpimp5jZ0mIqZNuLdRGnD3vGZE+YdpLKAb1PtFrEBCq2+",
+	"QfAN5Jh6PE86pUDD3TgSDbRbn2GcL3VA+sjhou5N+DHlwT7mcukpvodG3168PLJ8RlqrXgIFYOno2ioa",
+	"IdIfkDu69ezEsoNY0mLbVanZB1zdOMhO8na9qnZNfWVTOelYVRiZ3otzo8tV7V1WhU5TFhh8EeKRsT48",
+	"AxJYZaXxR1ka6IHLj8+7EJAcU2ha6cQxq5C0GLsBT8uJ8i9NZrR2rBC3oqA8wuxrj803PhhEusKnFQIi",
+	"QSOV18F2pJLrXpTWDbfgFgICDATEAa2ktQvw5Tob+BSpNR634f/ai+/GA2Vz/xpverJ2hZ4tdrZx5Q0j",
+	"oue1TkOvudg5XHQYWbuPK9WgGzIO1yfi+acCYbJtycuUWvUf+o4tIRw/qxHvgvtsiLCVbCqEL+bBnK4l",
+	"GIiEMR6lVzYlgVQt+18Gn25bD7U7/dtx5g/hg3NZGKgm0m2uc2AGg7U6ST4w+vXDr63p7facaHTtv51o",
+	"SuC2axdytek7prRZ8gIORzn1kXTXRtxKcdf8jWeZWHU5e3WsXyJdT96R6gvTzsmloCSjwMTwMEGur3CW",
+	"Nljb8Exfyzj56yGuer0rdx9GZkQhbrnKxLXNBgiIF6H5JbZumVoRjXG1pu2J9p+pPQmun9j6X46Pjk31",
+	"LN/rrpi+DTCJC3uli/VSm9VCZvU3a4wfEhJTF3Bm+B07ez5mnMy32tBTBl1UIKOmXk6lEqSHt2LFsY4n",
+	"CWqL9WohgnuOF9aEyldaKmfJUG1XWlHy0Vtu1vBQoig+iKqKMW9fWdDwE2peNR9c5aWKGR4d46vVRMVk",
+	"C+xHbZi330f065p9CWFY4OEzLZ2fJmWb1DMHaSlD+mKOZSNRjIfgw2AEtD7HQyYMSothZjWvJZr6RMH+",
+	"hAWYFeK9pPhq6I05z8X7lTASxScOnkCQH8eGLJ3MlmYGiVbV3UIWggllS9hniDtG5gPdcvoJWN6UW/Kf",
+	"kl42pSQUcAZ4iEmeqMbiUK6+WDwjRjqfPWfvUiGG9IDFFzOu6junV0fffXu01LdS2CMC825c+Tlhyp9S",
+	"5cJYB12n2o+Au/10opLDHCXBwrJ3YAUhIWlcwnq21DPI6aEJrsorbm48DWAC61tKDJ2H7B64PBh9SvDW",
+	"2JazXBh5yzH7JWxB2HGVx+ylPh7Pqx/iPnF7JO2Y0c4i/cXHBEebE1xKd0Y6QcO69UpmaGgi6rShscVW",
+	"aHUiixj+JpdLYoabCU4HL/dGNOlRyBJ7dCOmfHqUcSuOYmDpsEDTGnOKqUDabx9/y24Pe/sHt89iWwyX",
+	"u65JxsMZrk/TtikrNaGNN3Drv96gCOxZuNo++uu8LTbuKNMl1bcE59f2I/4qJIuvxiU2Xq3f2OvmgBGQ",
+	"Xg50Y0VdpJooq5cUssrov2tdUl6F2Qx8Lp3GTA6+NA3JaDF5Q000Q4JPIJ7csI01b6ubKbXoab/UKOKN",
+	"hUJjLI00VEj0XvC7jWL1zB3FCum7ZZ4drhtcSpslxAgzlc5wA9zIGY5sLXC6em7tGLneWnpfJGe3KdfK",
+	"Jg+ZbU+u2FM3quOQJI6uajl7uK/YzKmjLAL0ZVx8ApKj6ISVUAGvQn2bYfUHqRBOV5WfDQN1BJ2afvMl",
+	"uSV6RqEL7oAnKZbuHqNtfFB7rByKawIvmmFdfFsf0TSoD1VNDLEpg7qEMlCtIJQbqfLhQSjt2X4Y79Aj",
+	"YrFDH5rsTl1eU1qhXabid+HDVtpC35OaUmBFWx6lEOP3RhHpbOoX/V5jtGVSP9AYbKd354Yypf30bK9R",
+	"u5aHn92Orjgw7dnAOvgbXjvQn7pvXXqkt4+Ksq8EfA+UAyf4qFhvFMPdH306ex8V+VARdn+kPZP5qFjH",
+	"Knv7of2Ku2yxVQd0b+lo7xXoTMoUdEUDRBm/Ft6w0KnIbq7JfgwQu/ZyQGzR5UCyx2B9T5C+SV6ITC+X",
+	"QuVVuu7NMOtML4Vyw9J5ty+PTZw24P1aR+ZScFNflUNlLNj99tppOVMLvJmKe1OteMsLmTeTYDczVi1E",
+	"Uej/a71iCITk1PNkxxQ/Oz+aEX5UjA8zaNdTCKWqqqHoUSVvspgyO3gA48dYG4mRqVkqnyf2iEpnTNSc",
+	"g65OqvkY383KIwh/3WlzYxd6hf8WU6m4GTPhsmOGiPm02t50PVHwDgOlJSiDIBwyplbAX0ANiqVyOCt0",
+	"VmWuJFVhyMyIKrEXPFv4ufHCajYXzmJpXbCve4UhvOrhXVBaGyCtCq4UeCVXSV98Bjuvvwq1x2vFn8Rd",
+	"GIgK9YAtvVafET512NVxCSBxZSZdR0Tpkr+Xy3LJKLcb7Al3mEkLa0lxRzoG/Kk2XNJ2iqNtmE0rCofC",
+	"Bqz06dGZQpd39EDIcV8p9zBOcSqEsf9PJ/1vccSszXYr2calOVQ2z60jblhMApUN6vsyNH4g/zccpObv",
+	"6WQmV5TUcqULmQ1b0/N6x3PqB/CMXHKz3tEPtpZLb4iZCBGITkGUSSG4GF3vk/fl2nA1H7ZwV3IpLrA1",
+	"1EOQ1hsztvX9pWrZ4RpRZR6tYdSxQY2Rk0vwaxeb2En0aV4UKdHnk2ckaiYjGpR66NeId+1Ydlxo8X4A",
+	"/jgVwTC4WqwtcHK4wG6lcSUvoOJe/Dl0m6jqrlFV9ivDMq1NjgtgoaOHUQ1Xv6KkuiHG36d8CkMPYi3n",
+	"ofF45Ece1O0X37at7gl4X++WfCSN1IfxDr0iTt0Uvwk/ZQ/e3LiQb3JTcmG3QpUokay4uYH/W2eEcBPl",
+	"N9dLJXjtp3YTTvuYxcZwEdZpYaJO0SgLPVDgmArvfkEX6k9azzGT/4oEBBwt5TRbCamt67XgTroyF8mk",
+	"t82d3OW+Ct4ZhVbzbvidbz6fhaL/ydfErue918asrlxrk/+vXWLIJp2lpP7Nw9tFO28vXgLFQKS1rsm3",
+	"E5CFkZaeS5uBlYeqlW4jpbcXL1Nbf/8d/Jh7tCXQ4U8x708xb/7JxLQ0yQa/o+rR86ORObrWCGPH/q2D",
+	"rN0/dxY8u6G3UOdzJy60SqiOVpW+d2eXN12I3Xa6qkAzrGBam046aqZVdgpEKsLv5A01lLZFGMTX7BjT",
+	"D/lqt1jOzzb48eDgg9audEm/tTbtIJ1Y2ob2YRTwrGb/dOQNoY1kbkFP92l3b+u2hBpL4WatTQ+2ofta",
+	"TfGVGhy9EhgDWGiL6fJoJ6/BLWkgzHadnWqZAzz4F2FMDju5yAos69c9RPqactE2sIc233fuPAUfI4Qo",
+	"qRFMBKospZJLePbUkhagJ+NMGJ/PgN5N4P+gS+dz3CA7LArm1WqjrVM9tDjw5V/sQ5/Kmzz1oYWDwfH1",
+	"j0MiGBr+ntbhwCYNU+lEiutkC8G1uZJCZiiFHKEUckRCyBEJIEcggBz1CyDV+iSuWZgOw+lsPG4qt2S7",
+	"4ooty8LJVSFYzteo54CO6AiX83XqsSLIdDjMbQt1+kObb2wW9R3jgKk1bfhRptK5+KpyUuWYVUbNqaZc",
+	"VbhQqlDqDuORopdkFZnUVQHvrKdy+Scui3KmZonS1j9wK7NQvVoqgoy2jykwfViVZH2wP2uA3bsGmFZT",
+	"zUFdNB9Yz/hN7BAEuz9AIbGPWgOsQWKpHRpWJqxNfXXpdS7UNZej8ciKZS7ex5LIvhzWeGSXtq82Vgdt",
+	"D7UEtLunlv0MxGr+wAHZ1SA9oe5Vo3474lJY66WUAYURK6g7Ll7o1r9oD2NHkRH+DoimXSVqkIY5TGzu",
+	"Vdq1XO8VzNe7dXW0wxhJBNEt5GaHtxW07ooy2DMwMRlX+Gvq/VXIG4EFtBQKFOMqLRxwVOyIsTvHo565",
+	"7ka7vlOKcuH3jjDtU2YliCPMF3WeIeqkigkxaj7CYVUWkEaEuRBBgVfPHSSam6ipYJBk5kYWBYW0lRYX",
+	"IDxEYQ618HuPdUPQqrkuAMLPk3GxgN3WBzx0ry5RnNCQLunQGuo+9iOnaLOitK6IDB/I+xBBDz1hAzBq",
+	"F76XIa42Ec2gHS9qDihEEEZkQt6GmEmKnz3u3LxKq3Nv+RzXfbts/tInHX6gywzA7+iJBV2Gtez0B0yx",
+	"lnoGdpTRQtKQunwfbFkoOI1ZDca4skS2U7RT/cnaW1kvuVQdRKRuOp2LgIzerIRiP8GsQLnkdKYLJjDh",
+	"JPmcwTxWfA70xqYwbyypBa9UGoQCX63OJC8Yrk4yvQ3iQWg2UJhLtyinx5ledvU6WJ6IzaWoy7Xb+l1h",
+	"w8pk15su9eJl67x35ccH2A8jpoAN1I6e7nBckjIKgUk7fVQnp81AfDxdeFF7rzjyvkB+gVlF4k2TY+WF",
+	"VxRPXXAzF0krPNH9EBVYeGkqnQs7JOYhdMDcPEMepv3rFo8owQuI1ENN7Cgs4sdQSac44z4aadrBoI+2",
+	"pOxnTmu2BGbWo5JuE9tQoanRMy05tSZ3YE6RR961tSO1/DAezfitzLTaUXH7cOpewK7S9n5Ezjf0omrr",
+	"YOl6OMr08sjq0i2ygt/Zo+Dw3XVlXIXJdV515/6qS0FIVARvE5O0cEXm107rolfh8+rZOZyOgnK1eQUv",
+	"N4ItZJ4LRTnE0G2JK6Ud8D9waRYgrK+xN2khLOQXU4pUoP6nkA+A9EF3C6FIAMsWoFreUcuTeqBDBoM/",
+	"8338me/jz3wff+b7+EzyfVD6KgiLEPlz7sSD5lCgwWI1vY8wXmXBGF6npUqcECwgMVtwb7qEzRLfzxDd",
+	"eqHvFO9fxl7MmyqcZljZMj5x/byJj8eaaV5xkC6UvF9l66rAcvRFBUSuPbyk5t6XXzto/fNN4b+aTsSx",
+	"GvjXAVux+ejtdVVvTHngdBJ73XZD5zE/2jD/8yGDDJl9x1q3K8RaH8pPliK0BwUdYNebK8b3X0+lThLI",
+	"Ljs/9AUzdIaJt03VdatUSuVPpzpfXxdCzd3iesnf9wew+UTRzMp/C/a1VGy6dsJ+E9JeF2s21bmEqIpz",
+	"9AOEOw+Em0wEbR/2xCt6KpgR/yIJ1ZdYrzELS9h36ZJ90M3BkCd4Hwt7qOV2PS10dnNdbHGtxFbwB4js",
+	"2uSElR/bpwYOz2sjVtrAZu9qsEV8qPe+COGiNKMsCSCKCAuZi4kCBeEqrmywnsDaLe//+Ai5Lx5IIQLg",
+	"N1N8by4RMBBKIY167lxn5TK447FQgoMkNdT3YCJpIBVhKTB3ovjUOuMvSizFb3QJnJtZZ8rMYQVPvLJp",
+	"4gQCDPYx9nei3ALOe9QWTw1XuR2zJVfljCMM8JMGa7qGf+TSiMzhPzHiAWYKjy0KuWro3OKVvYpeviSY",
+	"FlZTXESV/to37dDubC5nx8GVqpXRCxb5+BC6vgcPUoA5buiF4BxcIyVcOyPEbqaUSEGYmhxT9+eCAZya",
+	"hgBebkJRDduGXQ/aVbWpSitmZYEkBlCaJxIiuFGryvgyGBAb5JtrfGcoQeo+JBN4cIWHLow1UZBEl31d",
+	"BeBYmYspN0zxWzlHPvkNICRsbWpAddYRg50ojnUPRc5uJceZ4Iw9zlWnn15c1Z6czTxvXZalUM5yJ0Xi",
+	"QziUApXcO0f4wPIJ3itrP53hPdP3DlM6AopR6cjnW0/0FZ9vaNYfxL006uebvkohB/Hmsfa4b3iVIvX8",
+	"2sEMt2VChzY/CQVELjw78rnV0inx8RNdIb5XXhUi0CYwUral7UTlWlCRkNLSm1W8lxbZUgCnlYeGyi3H",
+	"bwTpP7LSGARB+sevbOxhHXeCfe2VmWwyErl0KD9NRnR3TvV7RMhrEb4BtjNRVqggb0jFtMnJyhCwZivt",
+	"KO1cHImKo3DFXr58lXpK1i6BLW4svmHX/rX2Jljo2teawW8hPyXh6acA137cD786gPnD433F53ZnggIq",
+	"H0RN0PCxkhJO8qPTEe3HMCJyfL4zAQ1krnAzJZUW2H/rJKSDi2oQVfE6uUC/HsKqtZ0oavyYaIvXqQux",
+	"//jkRTszkL4Qx50pbBcv4C58+905QuirHRj7ip5j1Mk7GgzqeIltP7N3Q1ukfWjpdLiQGSS4ewcqN7d7",
+	"i1QMLbF0X+VC+2BCZ8UXh5u6DymZdp2XndSM4T2wqQ4KgA7vZjTYv+bKiLZrLvVOexdBp/4qfa+1E09Z",
+	"pfLBRzMoLXkmjiA+sm5CWwozD4mkw03S6WP0Jwf6wjhQqs7g42JG0YBYmqJV+nM8JNoirnvXa/QgtTFJ",
+	"Zdqqi/lfukT3CXJNIes/NP0K3SOGlcmUzlfKlM7GapkTRR21EkzPnsaqmONQEnOMJn+pcvE+1s+McZVG",
+	"oDBH9eErRpKqohnN37/HephdoYGBqkf5t99/x/+e6ye5+83xhfg/qvi2TXixImdzoV9pVL8GtSC28tUG",
+	"cerB00KCg0vS6baq29kLmZrtBro6uB3FbJW4CzuLg2DhS3YpHAjOCvWXmkEFafrs0zIarb2CeU8C76pQ",
+	"1CjAiYRLcaDFOnh1rKmOv2fiyUnHe2yX+xjKdjwL1bo77uZGm+HVhXdKoN5yWh8nK8Nf+9/W1wRhKGe8",
+	"xL/jhVabzMFWand2nXzo1sCMO+Zcr6++Q20Sz/vAzB9SNCAc/GDb3vy9Rdxfo19flSihL2LlwRxSxO2g",
+	"67nClHLt7pH1eo9ChOMRTW2vGpyDgn3rM+vIwrMZyRPWrDcdTx3us656q+NRe2GTe91IC+y90oycz9F8",
+	"Q0aWCs7xRNHCQ3o+z3XfNRrgSO+YUOUyaG/Wq+AD1nC/uA5lFPD/107HH1baguH5RiClwTVac7xYCuXV",
+	"7Yjx9QIaY1a+WPLvOuaRuQ7L6T+EpDLxd2opxLURcJ346g5g+MZij87Vf/LVWZJ+H/XV3vHVVXVMc/gm",
+	"4Id4hVUj7IRukkE2oQ2LTN0ESnXQ23xrb0ybkveOGI9Hm6C6c+TdizNsHXe3YO56byz/9XyAW0PHRP2j",
+	"umNF96H1OJ8tNN/OHVWqWIeFbz+M1H9vNKsQ0z4k/fK2yOG+YZ5JYmy/Rj9eopItgvV49AbyfTzjRTHl",
+	"2U1C8tB5R5UJx13qSztzjKMEzR2+kK0MG621eQ4O0yInbbUvE8adGAcXC4yz4Kjun8fHaJVHAuS2TFhw",
+	"re/KrAIvQKl8KnojMrQ7zKSxDkUlZoUrV8w6sbLNi9HP1F5j4+iVOa4+hLTS9d+W2kQPTjsab0Lx1YuA",
+	"9grhRPLAvIGS9qfoXuELez2Q31QcoytqPwhD0/W9Q/droH5NlkmgQv7kVcJuxJqcteAfKAbFQENeAKeB",
+	"z7YkFxeugkPyeKKk8y40eXRXRoc3NE3lEBNnneFOG3SaQ3XDDMX7amSLfjpGMAkGJyXgd3DJdtq/CEQj",
+	"ehrR89PDDzdi3eFZ1dzZndhgs2uKBbaBd9VbgTnuNl7yqkYwqWNfk3JWRZzmoSSk4CU8pK5RR0kWApBW",
+	"Vm8i0JbT0akKR7RBDb0KnapHWgwPSjgIkFXzetVM0lF7Lijxvu8zfLkGf9f0Z7IP2vRHTDaAsJMNNl/Y",
+	"caQKbBPGuDmdJD3E/EV1ycFnOTp/c3k1Go8uXpw+vz5/+8PLs8t/vHh+ffUP+OFyNB5tJEMajUevTl+f",
+	"/kQdL6s/n51evfjpzcXZi1qns9e/nF2d+m4bI7w8++Hi9OK/KgDVD5dvf3h1dhV+uH795vmL0Xj09vzl",
+	"m9Pn16eXly+uql4vfnnxGtF4eXZ5dX1+8ebHs5cvLuNw9HeF0bM3L1++CBPBLtUvsVejUZheo1n11zUh",
+	"C/hdvrg+f3Fx+eb16cvr02fPXlxeXv/84r9qS3T54urq7PVP9V/eXp6/eH3pofofL968fFH/88X5mwuc",
+	"4i9nL/4JkN+8pSmfPn919vrs8uri9OrNRfIqq3Z+J2ZXdUsxuvOFVsFz4Rkou7u9VFfQNGTWCJbxFV8X",
+	"muftcyl7hDiAlgsL5wJj9RRfoqoTY6j947s+WlOeqyJekxpY6HdN/QbMw+mQG8RLQ6T0YRk6YKrjAZVO",
+	"4zw3Bk+eXmhwiQ/wLauNLRm91QmbzqXuED1bHhMdguW5VErkF1wlYlrPyCN3pS2KAitsOvb5TKJUKZ1l",
+	"hqsbb4eg2EhqC8IkhqQcs5f6Thi/7mSUpCZsIefQoVxhjQqIgQU54t/C6GqMiSIFTg0ZpZ2H0BV+cK53",
+	"uS13Fvka6Q6G5UqBLt1+9TizenEr5sRypQ0v2EqKTFCJIzR0jsHs413XQ4wpmnT4RFGAitPxA/xu9VKg",
+	"wzwThRW1cgHTQkMlLKV0qTKxRNiUZOVc20oAlIocY2QGf2OMYkitBL5CfE3mZO4cRjwLpIG1LifqjivX",
+	"QIVTCE1Vs8Bi7TbvioMhwKaple8QAeuG3+QhgrAZcmBCRTSuL8gYsgrMRc9sVOU1IrTpEGHwK1c+CGHM",
+	"crHymR20orfUHffr44OFUXYFdSG7RAjWbxLY43x5jSmltSwwJARxM2zJzU1eiyagGGMclY5K6D1R8Chi",
+	"9OZ5j3hXERCXBXfi+F+WiVyCVB4CM5rrV7tRtN0ssbVJknahjYPkipgxUVfs4CtbW92ZT5mFYQwC/OHt",
+	"cdeA3eVwYCNiBYq4YRRz7rlIYD2W/au0xA7IgvajD8ySwo4nyvMnfF+Q85unPmg8xh/Q8jmmHD7+LoA1",
+	"D1bVlA8EdkmjDczqaMrpoOTifYi1h4PoCU4667FIJ54K9S2bwP/pT1KYduIctfTPsZDvr0kL5bwjWLe+",
+	"FHSwyVACc+CrleDGpjEPa9YB1n8NxEMANS0IjJkGapMWy6vmVnonyWpJjNau/gUH236Je+933IJfOxhN",
+	"v3oUzsKOfiq7OpF8BPer5MR7hBRylWxY/MKy0gb4QLgjzCgY1XPszMY330Tho4+y1iPvv6BjjPHTmNed",
+	"CJHYZoaXdG3A1EHdYzMowPIwyaFw+AbILpr6GBmOUlLKXhmO4u25kXGfFRru14kqVaXfIfWjv5dibFZ0",
+	"UTbeAowvmJ7bfb/ESI2eyVdPe03SPre7RdpRqOE+dtd6KPbTbQQQmlYa/B1c3jbv/F1STD73nGhXzuUj",
+	"0LcqmXjmdvEgI56ByXiGpm6iLjF500H8VEN65xBCRUSwERMVA6tiNH4z+p72IMkniFxevHfCKF6ETJFN",
+	"YgUpbP9aWth73JmNL4HBbscxMYPUoaRmP6JdXBjb4wGw2XQfdPoZRH0AqeZDcZFq/lC4HC5/8B4+JYlq",
+	"1vukDoafujMH1ya6zyJ25Q/eAPsQOSVvxC5IdmSUvOlWo29SydPfO+/vKktxw5rT1hotuMq3M0yfjOMf",
+	"1HgPB6Z/YUqi7bfFRvqigU7THr3gN21DSqJh4zUzGCVdmDz647Bc4xAwa3TRzbDRa67NpWe7Lt6QJTiv",
+	"J6eBNdDG9aSWGAYsZF1BbdzQTr9g481lnOE6+lXzxRoRxwC9bw135QPYqYMJRE/1jxw6cV93+m4/zb6V",
+	"q3vVtPSMvg1b+kakWQhO6CjshyYxmjBm4PCp/ibKaUaOY3H6DddPg8U10OG5+tXpCO6fC6FAXRmHCtZo",
+	"hGYh2wxQzclM5mNS0MHqA+mwTBflUtH2aO9anVr6j3rgBrkDa+MaJuePfhz9Qdx+9Pbyg9rs3HcUO4Mu",
+	"mr7Tj5+NDmWIfbtR8yPfdS+oa99OUIt+1kg7Wh3xdaiCQBV1nCVeAC0iN5hJUeS2ln4T61fDF+AK9JX0",
+	"77m0mVRZ4EW5cABUVTmnyCZCJhCMknkn83cEInASxarfAIhXHuWk7415U+CT8x4miJEKXKxqQupP0F7R",
+	"cN6k5ecT8mIFHQmmkpwomBMeK0hWNGvjo8nrltChxYOfM62spJwyHNZloqgHlhYF3T4pZJBxku+bEpa6",
+	"OcMlBXyTuzJfirAmn5oZHv7Y7HpgPKftYzCt7Hn0Dvb2WyqvZx1frkbjGP3367gb3i+BPbdbYBGzn8X6",
+	"mRE5RcS3j9jCuZV9enJyd3d3fPf9sTbzk6uLkzsxBZWCOnpy8j/kDASR1U0WoST2uVY+SptT53i2WKZj",
+	"6scjSgUAL3NlpVYXLWeXamFlXvu5gmD43VnHF++0M6SOWsT3InSqkcw2A/woYFEb0/dOUkh7L555qx2F",
+	"adndtkbQ3uQyc7mYHVG9uhuxrjYpGAV98bLUnjkHlDZEgXdaNX2m1a1Yc9Rh1jUIDQq4FF7NtNM+xF7P",
+	"gLkZySl8iReFUPM0jYv3aG+rVtUOv6raWxJ0lNqkbi4RKNbuMCsIF4n9niHln6lV6VCFuiqnfnyM5LwX",
+	"7lUsaAp3s9oD5MXqhXKhHppcCl12qKNKK8we8N9aYcIImy5+q5EHW6eA5H4nlnHgCaxt9x58sefs5RFw",
+	"yqKb5lzOcGVX2rgmFYRrYop6AKlInTkaj9QswyWawgpx+rxYT41MO/FvEsSgq7G9ZMlb0l+PHR72/bR6",
+	"2IWvMran+F0xr628v3AfZilgqIFr4f3g9roFtq6H95jruQNAgfxRuGc/Hzerjgt9K9/5BWtwVu4d4cCA",
+	"dK9Lw+eoSVvhXWVEXg/7/HWbib7CeehmBo554G1cCQQ7nJuo9Ds3Ld4OP7hBeN11brApHXODYRthG9Tm",
+	"6EakfUn675HDrjvQV+fK59KuCt6tUbjXztSf6/WBuvfJ6+vvadTf8GmQeqAy/Aep8ZDTG/fUu8atjMg4",
+	"Vp3uiG+aBWPaQEvGhp0uQvDp1wdDiNa1D+O9bRJL3sHL8JIW1u2VXxMLke4ZsXMfwweYgoblHq1qIfpM",
+	"r/vYYsN0HyKpzYZ9howmw/pc6CLuxEHtOtXB2GreGeOxq5+NOpU3dqpOa2EvQirUD1tZRTxMh7dO7n2u",
+	"k9aHClqHqbI9K6nmDzWrPXhNz6wA2oBZ7aaErfdM6mA3QR9+rXyCgd1w7bI9EaT0MqEHT8KTam+3KCwL",
+	"P8hv6AW2PEj9WRo0OvKkzm5tyGRNYjUvxGZN+8qxn7zmQpn2Y3am2Kx0pRHeuxn0y1iTmJfzpVAuGBk5",
+	"Q99v8KRbs1khcjA/ZqV1eukHs2u7WWS2ugsR6VYFlQbuFx4nsqz5WLRiTc7WvtTyxrQSIXk779rGLlD/",
+	"znV/uaVwg4mTwNVEt0WIeF1wHxq9EnpVoNvxoCNMVJ04uheC512x2Ge1crZ8qktXlbqiTAo+4yh5Lld1",
+	"ifCNiHm3ako8HyaFZgVoBn/EZFyNZgRnTTUKlHYTzChQ94CnrFY1SmO+vJ53l4/ltMhVzvuDpuwJBbfu",
+	"Gtoks+2gTcbPx9c8UhvIhkBjZhdQxA0GBZgxSc96ovDvzSlwj86wXD0+KuDayqTnzH54VvWl0WLjx2A4",
+	"Bu1ACvN0nNKmI1B9WTfRTx+KRgL61gx/bMfT1ErYlVbYMVVm47dcYg4EqpnI2aVYQiyDxJKEaibnZXDs",
+	"rqo25+I98jOVh7rXJXohFVC5TaKZULfqE1QKH4ws/mxjtMYDwqJ7yqSIO+I+GyFHQDbwu4V4N2wA4VNV",
+	"1JaincEvUKSifnrXPhI/1rx4F5IMvYuWWTKp1hJk0ImeqFpbCrNbAl+figaWANTyZRiywzkbp96ftPgj",
+	"hESE+exm19yzZCvO59eutdhJKsQe6SslUlRHGavtk43Ajda7147DTrt6X2+sVBi4Dq1z4aobtD1dKfJk",
+	"TOpAft3k1IFJU7WrO2EEW/JckIcBd6FbCEDuY9njeuKERISSdrxIjdyAvP0qqNdwo8XoWEVvdH8gHkoD",
+	"XIjZYK6oTS3KtQPhfuZB11WHKwE3c7E7ZftuIc5usPfzz9ChXRYg4NAE3D3fXRkE7GmaQ3hgh38oUja4",
+	"gch1pQNBCMOyoxGg/sA6UswMUcI1d3tYvjLCoC9TWZ2anx7Gk75jjHjAdjoMw9cn9cCm/dq7+z6L/Hmf",
+	"3978lI2J1Oxb9YyKPLtR+o4e5+SQoovbjtSKF8KilPazWF8QbstkKPtwo47xEG/E2lQQGzadvYxx4xGo",
+	"Yx/yjtGF6LsydCG2XRiFLs0uZp7xaBVTo+yQRSXJ97zW2CPRhNw1n90uBJ1WHwZAXempBmncK1V7S5Dr",
+	"CnKALv2M++NvSBLJL4JcHjR99xWfDz/YdTvZMHHwis+7n8hQ0QljDwo+FYVP/+azmqxQ5MUwcKzCqQ2m",
+	"C0AxWps5V9IKBrqXol7IDR+/63qgArSfycL5DA8+2UhNi+Erwl/xeXDL9a7DFpPZhdryIdkAn3tdmfQ5",
+	"9/EAj5nVkDHvK8t+KyUWP1oIfrsOAdVyFkOz6lHT1JnyV3BWyPnCCQOvE/hXyLsxhnkwzuqLH3Ju+Ews",
+	"MdSaz/0MRVdc9RWfP4vU3368EFHGgltdJAM367N0MfSrxrMLJwiQYrAMqh6boGsvqyuONhooIdKj5MVi",
+	"ZWfP7WAt7oYsscFG/aBdXHS/Co1DK4n5yhYdC8mXYutmxMIYQ6+TMGR6Kbqk3T1yl9udRLXkuqGMRrA6",
+	"Vm+PNAoJPtaTFCGabmq5aeCk1fLeLLV1QQMaEiNh+qNcq69CCdmQByFQMZ0Nbq3OJHfV+RC42Z3Ht5UV",
+	"oe+UDD4hjYVME8a2nAnVrbplIM+APJFcZ4GRbOlWMZ2B/geRzrdcwDUskjRGeXWGUxe2r6/mwapRDMzY",
+	"mUgbulvqTprCwTW8Mc3vTpxkV70wpYXbilqV+G6/GkT7pJz4yEXUuqsOEl673Rotsm4ziQj18OopnwNs",
+	"GJbpK9hD6CN51GgneCr1/QqkDjKfhZI+KHtbseKGB400y7ldsP+Xkh77hOWQ4g0lTWmpgpJlQuUrLZWz",
+	"lGnHrrRCafWWU01zMHA2DMU4+vFETdSPVTHOMZvLW1EzL8VL5Ow5e5fKfv4uVLSfKET+ndOro+++PVrq",
+	"WynsEYF5N65ygKOduFS5MNZB16n2IyCGTycqOcxREiyOnUZrokK2oFZ2d8z9WCnk+7O7JwfeSPl+tDJi",
+	"Jt+L/OhGTPkUxegjL1RtClnj0fujuT5qS15EMIdODPYnj/wEmc42edsjNUlvTKPn5Y0Na+lCYrrEpfbC",
+	"I5zDlhtL5DLTsl40vZ7MnZ7rNXOyP7nsrRWzsvD18RQVmGMFaF8nqsCYfz3zjfG5T3ZwK13p3RbQL2Gt",
+	"S5YSqoGwu2Tm1Kq0pdeB5+6Zb9e4CL3bBphoe4tP+YX17iHe5N+0Cg7zayl8IqjBuer2PfToizJU0x89",
+	"oqJ1fmjPyhQ8nNH0P7j9ZDcycSHoDeSa2bi6paWrwMxSm+sKUb+rm0mr/yGKQrM7bYr8/0ntJvCzhNBx",
+	"J6aM57kR1tYJg4pdtoFsxOC0jAozjiJZQ+u/r6mhtMLc1gY7sL3hlwZnj8AMn2GqMuQXHgrkm6XQw0La",
+	"xVZ4ITdFBxc4iNhdA5Kipn+KKcSlqnoAzf4ByLQvNnPqqDPm+ChGzKYy1AQ09og028S8dQgj7PZCgAVR",
+	"ZKWRPgUFYUPFQ65vCB0cGjpNBTcUlU9AYEUwc6fRdz7mVcJKZVrfyOjJDyRAQuyRFZQFP0LgK+lzsYR1",
+	"3A4krngntA8YOTLToZy9d4n2gH7gRvHpmv0shBKt1I2jKHGjRqhgp+dnlDO6lEVORf6Xy1KBX11uUOpf",
+	"FdyhFO612BECdI3XM88poa9mViy5cjILumUAOi0dlvlB58oV+apwZnSBRU+xxouYUyJjFiKJohth0JFN",
+	"jeA3iCKmEcLEHtJWtWZyreARJFUoIOMdig3Lxa0o9GoplAs1iBCyzys+FR4kFajxTtAgutfnELH0Mgd5",
+	"VB+zt4WTS+4E5Bt3mEgECyWzO76u1soZnt3YAA4TLcPdi+mmYd0o5ROzwjEjCsGtIAV09JD2cgddD5Fa",
+	"4OohkKOno9vvjp/87fjJUcYVN0h1eiUUX8nR09H3x98dU4Vct8AzcBKrHj39fTQXCYHiJ+FaElpwI45o",
+	"pR2j4GaKuU4g1nPkQ25+Eq6WQwHHfvLtt11MIbY7qbq/+Rkm9v23f9ne6bV2r3QOz5cc+vzl2++293mr",
+	"yClf2tBp2EA/6lLldNr8Fbit05mP7r7ES+6FMZrcs0gw+e9R3J9fMae2yxbtLaJifwffJQLr709h3Q89",
+	"L8yqiaz2yQP4cI+tJhBvfn7cO/dhXB20EyuK2QkgebQUbqHz7qN3IZyR4lagwY7eSryRZSLYD40NgRuz",
+	"gs9DMQssJL2Q2WKitPI55njmoATJUNKYqC7iALHi3I+OQvE9NnkTVtjuARB+gNcWkt6n2buT3+Gva/rr",
+	"WuYfaBcL4USqbh78ToonX+dM5PWVhy0lUBRAUqtY5m85cJGXxghk9+BBv9B38AeYfdHcmYYmaVD0vjcC",
+	"LkcM/QhjaVMfysds1LJUgVZuxmURqOwv337Lpviox6XfQiavcBSaPN49VSKI//ZiENxHlRDUXNJG0WaK",
+	"Ka5KY29GVP/6ByLDW+44iqMrnbLOvV0VGuQsxahltc073QKXwp3SSK2tS02uanLiNY0vhZq7xYi2Zr+L",
+	"pMKh4y7ZKIL/xV0XcGQL273XpzluNDYL7/ig79ltu18AiNM8v8e1H0Hc5+JHIM3bf+dzuBcFfMwNPfkd",
+	"/3/td2zb/XGBFbbbG13dFbtvNcHc+WyHPYbxz55jbp9RF/NNH84vZDd/9/+6Jv/oDzW23PmcarPkmjSw",
+	"/em0JztuZLPo37Ghr7CKKX8hzLa1m+iYevI7/G/Y6fQKDUGHspYXnVHKCBsrm8C+14s3sowrjKQtrdiQ",
+	"wI7Zab6UyvomjErt05GHD7UR3UIsrShug1dekogIVXT13ZWKoFM88OOPTnRfxnsQdMjpWzySj9O7EU/l",
+	"2TtRnkoSdNQjqOf5n/TwKHjQyZTnczGEE1E1q3xesQbmE2v412RU29YYSmQlFA8c34T4doRfbqWFyGsE",
+	"fORzDLT9FgOoPi6kIcoHBv4BZ/Qn6X0+rOi5sHPJVVtbgeRB9TyJsrRpEtYbhVX3aPcnyivWrXC9vS6F",
+	"C+lKNgYAjYdQThoINuDCuoUAqwLo7SP5zg2W/lRrEImld5eqOKI9ZkArNmITCr8Hbgo9a81Bta9NTvXI",
+	"gnM/t4SQ3ULRl8L9Sc6fGSf1klunQJ4Lx2VRSd8NNfp0Db5wzNu4Y+lWJN+KZiaqUWibacMalbbRKSXo",
+	"aZtNM64YmJaBDCcqoICuaD73SgNSLSjELbQVCZDHE4XHcFmTGjaAxEHJ96X5MaxgD6n/4m3h+zxBtj0Y",
+	"dzMC7Ums32/v9KM2U5nnQn1e5A0S/wCbAZVBxGQqRMiWeKxF7iuVdbwo/PPiarOO7USRPR14LprJUdmc",
+	"si4hIJWJWvUFepQcgcSA9OyVUVYoK9H80MTra6FupdEK7bK33EjwcrTf+CAqwjlJiTCKvzjs3ibFDSD3",
+	"oKnDbTju8HaDn9LqSKjbwdvcv4L3MPclwHy492Y8buWf38J4YE/oHECK226DH1gd8OD6QwON40EjISie",
+	"t2gQ2syp5PREkVYgMI5Q4iMEKS654nPRHAQeCHQV9DJ/gHuK/X4W6/3tfi0w99jmXRn5x9ljFD68e9F2",
+	"zdGtvhH+ve+3xG8vmt7kcilyib4lTKpbXsho778Ra9pdyDQjMckaK7SaC0OCK1IEesE07ILb97bLXLf9",
+	"hqf+PXf8oHu05qb++Kki+tqlrTfo2iaoKg9b6jzYXaljDLmFF5FitaRoq9JQVfPmRr6KEE59ZQ2xJ2Pv",
+	"gNTm7QNY7WmZS/fiVihHUPIvh7PDzI4ETG0ba4eWjFo2BagoiZ3Wm6C7GJPLlTaOg7trTDjJbwS+TCKL",
+	"RxEfE/Q5kTces5F8ANmJalwKnti0sVh1jxdWVzHpAubOCu1FCcr5yUI5iomqls/H0+uyyEOWR1rQnC4c",
+	"mCZVAGK5NCIDhxOP1kRNeXYzNyA1s3/pKfoZlEbYkOmvJtlIa8uO93ckLn8n7ca1fEiY1Oo/S4E+c9sZ",
+	"XRwR8uP/iGH/+3SWS3HB1Vz4vvu9iBqz/yLPFfjZ5NJd41+97/ma05TXWmX1c+ef8z0URC+JHa+92Pue",
+	"b9s6Fo9TF9PaxilXbaV2nzj0E/ob15TPVJ7Op1of15XV8VdMdiuOO4UarFfA1Z7W7gcwnT7qzR13CDI+",
+	"H37NcsWOmNUzx2ivg9lBolxKqmVO4QvhOqwUYPpOkQa20ODSiPcBmbREdEbHW+tGiJVt0AsYwYzItCHf",
+	"Nohg4lRwNohOVrO35LYO8V3oUo6wokqZPMGhauPaLbDYZGFFLcNyGCrcrvgbmsPHmEpjzITL+sRsT5FR",
+	"OPuTIu/NbqwVzg5wiMsr73+GLyuGRoj2VgFA6nVf57cBMgEMBgGtg6WIc26Ectjv7HldfthVfVOb5n5q",
+	"mwrAZ6E+IzqoE8XJ7/j/a9hnOJ3d4sNzfaei3yT0AYFBOpsWGqDBXvICdDznbnGvo+tHf5wHt7FJpVsc",
+	"wgv+uIq0seUKM4SCTzxkTr/jayoKXnUVY1J7+XoDK27tnTY5NnsDzsDIKkIIHd1dExVyKTAnigLA+4cN",
+	"etojeJbxFd1qobK7UHDf5emXyyH86D8/z2XY0Wpz76/9TLo2Yu33ZgfwWuCO6hJgOBg+GvMuLSr4zcMu",
+	"ow5VzurFESaqOrAhGTqOhnj5xBa1Sgp1aRWYB9xMHQa0+6pPH73mlKhjPEQfVu3tFgd2dlojG24EOtLl",
+	"m2cewxX9plkG5l2x4MUsuKzEPVQ+AHCiwLmgLHhI6mduZSaOZkYKlRcU3ucWsN/MR2oyiunE7Cl1lOwC",
+	"WEHMeo9ePQizrqzxkqS+UzWKmqhIop7VMU4Da0rYp9i7U+Lr/0Y6e8cWgufCADiusKmeTRSqbnhGgUEh",
+	"d0s9jrOFMyqEMIkMwBHvV9KsGSmfdXDrAAldLqWDUBTUPTMOndENrZ4asbELfM7hCCIGNHD3Odlff7kJ",
+	"4sO9ThsBeUznLQQ9o0gS45f/m+qEbefUj9CG8af54sAXN0YaHAXZCADR1Z3m3H4OUZZi2N6HKwSWUZU+",
+	"qNzKGgENnXKSh3oBQP1QGIiwF28o3QI7N6B+yQFG/Ttr5VxJ1b21l3KuMOZd01Ugm0KPjwz0+wi3mgd8",
+	"nNzKxspf0tCH2MQ9WXzpFpclnv0vdWvLVd+pnUuLaYuDxHWQLS1XO/PfM6iESmBJo1Hnwp8NbXw+zyrc",
+	"m8McXVXb6JhyMO44JLuGUoELfit91mYMLYiv4VyshMpRogY5sOEZJi2rynpBcbmJwrH+V7wmfHxyTNrj",
+	"45bHjHtpGloY4UqjBEjCzNKOTBSmFJmxJZ/LDBW99OKOkMb+1efRRPnCOm5I9Mx0Ltis0HddVw4S0AH4",
+	"0598qUmue7Oj7WQa/5rUU0UBARGNCuW2UynJm/H51dQ3ISYNiUVY9nUk5ltbI8fjb+BNhcX/YLRGL8xZ",
+	"Q/ZyoZjx0yaalXaTaIXKJ4qzeiosDy7mAPBN8dVGp6X1LEX3sBnPQD3FHR6UowbI0oKpRM827SyzNv4T",
+	"xQsjeL4mnmLHlLumMRwiNBUeHZE3fOtXBm2yE8XNVDoD6XLCbmdaOaMLynK65IXMpC4t45nTBiuZ4hJl",
+	"3IpxhZh/PwQpEx+Z1UsXn91vrs6r7BfcCp9BO1a7XIBLQlYIbijlnzR+Jpgo0N5Jly1EDqmEZCYwodGC",
+	"ow1pLZzfG/hc0kLju17NKwwBCAdrmLwVZh2cHaoJWaHijML2ZxzdNnwAxWRkBNBCghAmo1rShpo7LlFW",
+	"DAGbqDOfukga6/wacvbk229ZONpwGLyqoZbmtbm1Y1Ao+N8zrfII6C9PnnQDotSOCVVJsPpiAlZybOSK",
+	"lRvlSeOiUEMj53NhbMUWYNFrjwwM7EBH5kCzYzglr95eXgGVQOEECSkx4CSgEqNbSRtvgs9FrPl04sxf",
+	"njxpc+1f2nwJdwGOSI0thAMaiOL4I1w4eFLW3RcOor5ux9WXlkKSnL4JpHnHLTUinZZWgVVGu/VXtnU1",
+	"+IgRCxxCcgb3HytXyApyOBcFd8L00h1heC8JxIP4Uw5xi5NCz3XpOg0R58JQRmzO/nF1dc6oOVxFeDEE",
+	"hr5x04FEYgT5t2ETPVFez1GVkVxxEGJI+JwZVBJBsu13/3zxw/Xp8+cXLy4v3x2zq/VKZrzAgEtZha1x",
+	"z2nhnvQ4GV06AeJMHSBDg9YyhmOGajcTRd43yBZD46PoJeVBOm5vbOVdrgRsOwwpFbJ4O1HVnVkNaZkp",
+	"FWqt4fJhuZzNhEFZy8g5PT68sjco0Scquiau5LGVThxnegniU/z3VGS8tII9g3U/upROHEEJg6qs8ESR",
+	"ppukfrjhj/x4QCiFpLjAnN1hHt87bW5YZrS1vtVWixwRSovfb9ALbKqvRCzCRBtbCj8G2mBOH7PXGpWf",
+	"1WUHoh0SB3nzK3Kk5JRx+O3Fy5q41JgB+gPj37BoExVGsSiyAYzAaccRA7RwNvHD+spY9oiWBLMy/YY+",
+	"BTEtU+g+2iUB0/ffPklJ+HEpajpAmKU2bKGXAjEZjUd+cwHCM54txNEzEgtjws4kDuPRBr1sa/5S0721",
+	"rd2lcEfP8LT3t/ywr/Jd439/x/9d+40zH06AF4CbbPcVhvbqJyw0bGto3tTJ+lmAt6sg04Cyn/ySRuTP",
+	"a8ktTsILErc5HflV+UYmDM8LfCAEKBvmkjErY5rIiYqNtCLnpy0q93sEh7Wh/KE2ewc20GUP79306LGI",
+	"Lg/d2w9BYXn395Ap0GlSI/gnH1X8iPqVLVRyD0ttG8qfVLLlshhqlHsGkpBwdeI4wi6o+ex65cRXO8kz",
+	"E0XB5PiC4d6u5/ewpnUIEt27tHnt3SDT3n0JqNeS98e8Ug5k3istjL4UA8xBhzHu/WnX69zN/S16e+7i",
+	"Z6D4+oJNeauFVqLnfEab1ca9jTzcbyzC8CVRyRZCD37TNCFoRdVeyPzl36uR39eBeK/WZUmuWqrmwEHp",
+	"oShknLpUullNymnKF+UhAa013H56ckyfAzy/6M90Lj4p3bWQ+UJpLxmjtSr7BAqkmzq5pGhzuma+bn1Q",
+	"nAX6mygiwCBy1F2DgEd9ZQl6J4lcIty9KKQzgGYf6qjh8eURR6hEgqEUZoiciba1WLiFUT+0Samc2Yag",
+	"0ZnuNLjdv+I34jQA2EeKSAP64z4uqhI0/a+LjW1Pcoe56L2pwtLXKADN6m35snv/Ictsbfs/UZRcCpsv",
+	"QqKMu7zkN2LA0Y5bWrcpo2UEyzOpuZc4q+Pff7Sr+k6f9I7vQOnxMvP7HXkghnsd+AZ1hGDL6bqhv6rT",
+	"SOKCD7CC5LU/oRycC7RQ+qwu7angme556Z+yDHTLRxDKFEV2dImBbCOwNVh2EwPqbWVpYxgG7VN9YHgN",
+	"hkkbCdJeEcS2WakwRQmAafkQXTW8mqQFBxRBwS0zbebCNbN6Bg8mBYkMOYCclb76JjvzDl0gTYg8uH1g",
+	"qEnUXb5T/FbOOTgMWaHyH3Bd3qEFUirmlWyWEmKZGz+/yigJDmIzbliu72o1j7lPqojKdvhlzDQ8kwSu",
+	"kTaIOZ+ol3KK/kzn4E0Va5NBuT4ncmZERuW8YCJg3f2tFCUJTmijxKh17sC+6U8PHhmys8II85IbrpzA",
+	"uXt/Cmgm8kakBdy2GFOXOmGXcVH2kat8zzaLTNj7IKxi5cTBpZkaL1tKm/kD4Mur+pqD3Vn4q3BSSJUY",
+	"OwVrOhqhW4sWarLuHbxXB/Dm54OsSFiD2sQHBNf51hRWp82cK4lUBt1s98T31/FvQPhwn9W7dyzWpwxQ",
+	"b+xTk2JPfg/bcg0V0weUk6rt5DE7LQraPyajh6Tf5eB4tdS3VVKmyvjuODLgCKpz//eMrArdL4tyfg9B",
+	"bQOLe9EQwfi4NPTpJP8N5tDJFlOVuLdTxT5JELpIYt/9vGdZyM9kY/pTvlZ78ZWtb1X3zkTL/Sc9r/ex",
+	"/DdhfPk8/2SlrQzuSNtLftYIInQMtWmdEeKY/ZcuUcaklEb4YcUN+t2T7fcd/fluDBLmiTbMiAipPgLj",
+	"Swjvls4yyAeNzwGEMFHexfXdVMy0Ee9A8HzHZ06Yd5j4fLOiIIgcueHzI67yo9zolQ9On/EsneCvSQPn",
+	"YYE+C6qO2Hw4jDz4B7uL8DDUquJvTQ9Sa+ydFyiYoXACfXN9JfIES4wd98oS2dAj1DVO21M1VSP/g9sz",
+	"J5YthdXOZNOYy5ufP/GG1vZvyNMjNkdOkGGCz/D0YKXKRV+ijxR7iADv8TzZhPHhfvvSfKJ80runsTsb",
+	"5+3k9+qPa1CEDHxzVFuo75TIQbu3QwnCapn2fU9EAK+4udmnAOHj4pgbB6xHq1HbmSp1GavWC6vIocqI",
+	"AqO0YSsjb+FkWu/qFfCiRyOFTTKtvDdALc/Rkirx11wTSUnlQ2LCo7LCSFo/7DgMOvb041VnTWIacuL3",
+	"enrsQD1Dz/tjzcTW4t3bHiCHOvn7vkw6925vhn+v18kGlC+ABrbeECdK5/Bugf8NLVrLFMbaY1XMGg2R",
+	"m1L1N/kaTUWDtqqksG2G088caPTX+3iIJOlsu6gHY90vCXQK+y+Ds5RdpauJOJzenTSqIP0EaSAABO2v",
+	"vBgPbBcipy/okLDGf5NJq/oOoauNsTZYn+mnvdM8f6yE51H/Q/AyfHSc/A7/G8zLoPEn4mXn2rqPRVIw",
+	"1mF5GUD80nkZEsfD8DIEneRlK+1tmWrNbqTKt7Kmx0pHHvUvhDXl3PG54avu9MeoKfK5R7nJFiGFfVuy",
+	"fh5gXWLD3QuQUracnLoPTkMeh/1Zqnz3XpS4dPd+QW86uOcVn0N6dVCX7aa8a1WH2YuCN3bnUdJvRa0b",
+	"1HvC7U0nBZ/aG0ZuX5jhNpZQyPRyWSrpwHKxnahP7c3HomhKrP+fHuWz5/fd8VN784Vt9xJ0BD3+NcS0",
+	"YJNjH1TLL2FS5Gy2Xgm+QEezTChupLZtB7GJomwIGSZWuFsIxTh7d/ni9OLZP67PL978cvb8xcU7ckmL",
+	"Cd9n3LqQhNYXPTyeqGaF05gxPgYs/lBginmVM0hOYDEl0VU7C1fMqrWUihwnrMB71whbFs5W9a1CyfWJ",
+	"qmUV8ywcsy2MYz6kRS02AtZrym0olQKemBYz49pSupgJd0UZSjBvWVVWtbTiCDN0xFnBKh/5ZcahxxP1",
+	"f9lSqOCjR15tQP1zYcfs2dXFy//1M7NuXQhoVtqxrxNmKEvThZ8mLoZfTtgTEDnesZkUBRXYsAttXDjV",
+	"Y3xJYRelHS6I41IxoguRzyF9WkCZiN8u5GpM+fyokso3PqcWwLTOcKkckAe5GKLFoFjDhOorjJg4jQVi",
+	"2Iqvsa6Dlf+GBVryokg/4OKxfeWJ/BPeo/fjO34CXwbv0Vk3u2keVDqjlFHyzUoocPjMdVZWCXFCArh6",
+	"7nOGxfEUi0nSbwX7x9WrlwwPmqsS4pRWgB8qwMjFrSiAeiy7W2h2x31knHi/KrTPkAOgkQ6FdRFHG8/+",
+	"nZF49jOdJ+OcfhLuOUw9TQj+gME/nXjvThZuuSU3yofxxtq9+fkBvDJtuVxys4bLf3PxR0mfzUHVE8Fh",
+	"GdvtZvbdvy7gznLDIQTFViG/T3UE/Z4MrNOArY8ZZrrkiv6E44KBIQJTuXoXaunrCvgvE0VmpVh3ErZ1",
+	"Kbii4h+5tFlJibYg9QB89HAo4daqWMMZS3qN1MqN7mMRrnf/sPdWfj524Lih1Yk7+R3/P9zw63e245Tt",
+	"aczFvn8IO27tTHWbcFVVqrKr8tTelSoHLvUAun6s9s46W+s3dQZaD8lvQ/FAEnNBFMCGIV+vtMw6bShB",
+	"Ndm/PaOyVmcSWlbBKQh5zAz3sTVcVT/DrotiBsEhX1k2USttwd8Oxd+YxAlTxyH4+OTw3nz0s31X+dt1",
+	"M8c9bbBJKtqHu97H8loD8LgJsYMdw4I7mckVxy8hHG+wjaLq7U0VkZ4vsQBRiQWILMN1PK9a05KGLI9K",
+	"q6MlVyDazH3sk0V3UnyaV7VYl1YUt8JiakOs+Xnka352kV5txD3Lsm5S4XioC982XfSXddH0mSpqNOIz",
+	"/9xSzs7gLVwP1q61/sr6iruYTno2oBQapXYscstenb4+/enF9YtfXry+uqxVvxoDwxRrtG80fZVp1BBM",
+	"uhIGK+t5a0es//UGWOmdtKIOCKm0giYNWFw6YeJ0ftQmTfVfy2NxTAF+YVJVos6Ftu4bughA0zFRM011",
+	"s5h1RmZOGFoxtuTZQioRH6FNXKBNacOVM1GpryEI0ArHvlZ6A4IvfIuJt4UVyn3DtJkoX6prMspFVkgl",
+	"8slo7EVtmF11pLEhrpQfDXvFFLaT0UT5QnlEKytdyGwN48UhJIRmi2sANxnVN4bhvsBQ0BbUWtieOydU",
+	"Do7ko3jZerTwsUBJ5j34KueyFbSkNmx4zctdtmZLxc1SOwuEUtX89ZM3uhCxyp8/lqiSDOgKASuIS9ai",
+	"lBoJ148YwLT1I+NXsEmNW9aTYSIePxJVWRu2bww1FiFDhzTNcfdAKyu0JTqSwBA4U/pIr7ye0FfYw0Az",
+	"LN5hdWkygXl6ZS6WK42yFCUYlDl5jhXRjXCKQsLxRJ2BMtdZSn5PT8YjbY68HMSzkOy+ia20gS8clUr+",
+	"Vg66hg4kDO15De0jPrWR//Dl32ggLkk1073RvUDGU25lBny2XFKZj6Lw1KFmutKRS1eIMauBCLW7g9XA",
+	"+jzMsZZAVDVyC4wmN/J2o5q508wI9GO3rpzNJqqQN6SN/AmV3kvhOKg4x2zGb2UGYyIetoGIHZN/vOF3",
+	"hTC2Qz94BmuxjwDt+z6IBjCh44NVP5lypYQZsHXQjMklZKRuTfoH/PqT2LN8aqNu8sPOezy8FnksRuOp",
+	"9Cs7aBViffKHqPt9MLZxMC6wSU+yN9fFsGWGxPddi3yWaUVQ/tBLfPI7/PcajGcfth5eWs9Mq75F3Ud5",
+	"Bf0u5b/FQQqmfwyGFzIU2QHVzdGgGjtsK3bcMHlNVNMuZRf6LhhIsKoRadjr4FFexpTRFh98JUZuBF28",
+	"VsLWamhzn79j+2uv/jga133armXOsJ4Aw/1kExU84MRvZZU/5uw50y34odBGVWHl7Pnwh2cvGku+rjLH",
+	"4KXtt2NzKziLdTISD056q6VdBRL76muWA5TkpV6ltrpPoGIiLdauJ6aJyKMUG+uHcLspS9X2atsRvEAc",
+	"chuVuhNV6wzSnT93G4WwyYOhzEBr4AXKW6FybWIplolqJNCCwhiVxbMaA1IA4MNpJoVJjAUWbagIYYmy",
+	"axArzTB8kirHudUPCibkxKHSJbEqytjfvtaC8eF+NHpvS9vnQqUbl8fJ79Uf29S/lZ2u6nPMTmdO+Mc/",
+	"vm+kCzoPTyvHPRu8p1Gvnp/vi1e3bnKZ/rueVEqOy8JrMetcx1v9qpOduuyJb6BvXCa8dYirvHH8nUZB",
+	"oA47DEppGiiFc1ZIgZdqg0N0FUWtdnUvAW4wTQw984/VCtk+8KAhsLtHo1hMZHYjTm61E9HrMH1nVTpn",
+	"DREFZ86rqr07YbhehLEiaNdJi2mDfFaJYLyYayPdYglZp6xG1Wil1xszq5kRK/TwAHL0ocSaKY2J9hhm",
+	"B2FTgf9GLR4aTrOkpu6lvMHQkT0NRUPiD74AJoQU1M9+BGqqQP7ExpEgfJ0XJAsw4K3IlUnk7Ou1cMff",
+	"dO7IPlzg/uEgtdEf+U71GOeqU43BRLQ5p2yCvScjb+Fxbs2WoMq8A5eAtS6/ypl4vxIZnnZwaVyzpc6F",
+	"UQy9EIqYkHMcCwZTIinypxMir852MIDUq1saAY77QuVegKwVmi28oTCwGO8IAaYGo32ZqbNK9x8pyhfh",
+	"7eMXfVzhNM//ZAn9hFa7YGgn7PD8vk2+gQoe5B3eByUyDwKMyU7xl+P0hlGzn8Te79pGIt+P5ZXZRP0L",
+	"oAV1M8DdFpvt5m37Uqqbx+NsG7D91L62tB/d+olwI6ibIInF6Ck21foGHIZCAE1VA95mhq9E3XdtoriL",
+	"2W39WVY3zDulOz2G3C3B3yza4n39DpFTa1SuobID6rnQbzPMgswdFqI3glut2NehBSgwSOVRGoy4h3AT",
+	"hgmcef4NPkNUdJZH9KEwOoW8BktZFFUCChjiQc52ltKt13WCGyg3C9XHi29KL+XElTSeqFIVwWAw1fma",
+	"+bAVy3ieY8I3XkTsfAV3YamqvB1HVL+C+r1hDmFQ7zhYuQOCB3VsFbwOYNlAsatICCf1KzlYx1WI88Tb",
+	"nHJqW4fGecHR74GUP+QUhnV/+XwpOhSPcBz21+fUen/Y9zB+Pt7S4UhGdnnyO/yvysvbawMJL+0N3TFA",
+	"OGaX3vRMYg86T6CeHc6+yMdBCx98Jiw1gb70rAcCgZf9EjbUyaWwNSB6JVRaZwfru8+9C/3um6TVj/25",
+	"8FnYVExi038HYpPa/UeSDt2C9pg9a2pbMIM9VWzGzJuJLYCsGp/kdhwn54euOTBJJClMrriQBWVGwbs9",
+	"VQfaZ/1plIFOoUNf7clZ1GSNPrTxuARC9r6kFFtYS4lQufF0IUOHfzAuDRGS0Nmykr9IK8mpY7DEeWWE",
+	"eC5WbjG4RyCLHzHW7D7nLED61AeNDteQ2CFMC1XPAhklhZzdKH1XiHwumNNzLLHQdaj2v7VqvT/su+Kf",
+	"z60V1j0yOJ+la3g2+cgOSGQIPMEIRcWBrc8eDHKc0ToRCgQrsqfRALrWrpoBZw1TDIZu93kKVFg/ytdd",
+	"deB6ckPi3noDAwrlRTlP798+csLOm4dHxxPXpTbuI7/p/TzvkzT+kZLIthyP0DJNF3v6yG6Qxq978un7",
+	"hAtV/R/1+U4ydizSh0FC8P+hIUJUmC8mMuvedOqA7lMPzxRwmPuZB76Qre6zDoS9Q9NA986d5vmf2/ZZ",
+	"nNAgRPXXpPIK9tAYrbD+1Yl3d/UUjfWa/WvUF/OeU8yQ3xWvEax7BYCoTa7pAVLtyRec73DEiSJR0LKN",
+	"tBiUh4aUF7V4rPoo3EKyu3KZDj0Nj5Rw9z8mSWN86Kd6R16yg7z+vsDzc+Ipbn1Uvfh7xRkbjgv2YtQr",
+	"EHr9oEVlCNXRCp8wyxAPx4+U5pYvRYA00yZAh1NAWgw4WxLLBsJZOUKLrapU4HBWp2LBb6UuzTG7FAIV",
+	"9k9ZxQLPPcKXOErHIaKmgbCbXT6tjLaByz0ltia0L5G6q0Q+aX3JT0LB5hMha2CxMRuBt4tUtdyIhv/p",
+	"822Bd03Ji2INLtcuuHk2W48xJELwfCPLGQ3GCwilquU10KVblVFuLLial2DQWepcQCXFdLlJem3RLJ75",
+	"6X4iEt1E48P+r8cGoM+8fs9fh4zyWruz5aoQS6Hcx9RNtX65Rga8a275mn4qKrKmPItmU6dXrBC3opNE",
+	"75Exfi+pBDogA7/vvU+II6gv8dVzGRVYX8UdblWxVLRtyXfQI9zS0zx//PuZPu271bgL256obzf2gQ/k",
+	"kAL3HLyi9B2ZXidkOw9PnSb5+KJ1aFClGtehIoDT7J0qi+IdAZ8oK26FsbXaeVFDbiPgQI6oFN/IZQrS",
+	"3UTVEFvq2w2krDaumiF4BkgVUASulpWGivYRAqHutAqgZFAGiDuPY2fpPT5RUH1vju84Z4RgsfoeQPVS",
+	"a/Xjca/4uXc1vsMKnPeqwtdWPXzpNfi2HM/4oBl2QDfSsngR9LW4i68kKYrcBvHSYjINL002X2RkokC3",
+	"8OAlQ9EK7JYXpbCYQIJbKvxe83iC02U1IsLn3DvNFkWoVOn1G9xHPuKXBTet59wWUq+W5XN4XQEeh3lZ",
+	"ySpN7J+EfyDtQt21ol4z9aOrF86b2NERKrS2AtLGVNZ2H0A0ga3SS44JWSB7Erchs4w/glYvBbodgT86",
+	"uOqJnFqFHM8+bGSioj9beF/+q7SOrX2eaCaWK7cmqHSXGcEhDxB4N6EnYbi9KVTJL0ldntdGgoKuwFTX",
+	"7Gu6veCfQBvcYWAUetndeW/licLPEN7o+UoY45v4+OVSNYHjNMqVVkyJ9w6xDDnFMX+Vsz6MCgNlSpXr",
+	"zcAZj7rgVhZrkCoKQXIKTu63UmY3oU3oGVIEQ3clQnwyvni0CYkA/Y7QVAYxrz/VQ4+PK1Gr4bohaD9c",
+	"McRILzRR7dY7KYYY6YUman/F0BVM9BNrhRCHe6uEAMqf+qD70Lx0hRhA9LxG9tDlUSpEr3Cyn5rwEYn7",
+	"Uz6A+ZP070H6t9HndNjrq2pff31hpIAPHfApiiFBojNyPheGocZjomqpIEJGNKXBXTejX0+UuLOFcN7j",
+	"ua5NaQyLkYYU2ovJAWNpMopU1DNHiWRALFOSHHytXgrCg1mZCyZmM5E52y/GVA65n+K8VKP/6YvkqbdG",
+	"LFtjCPHh3eiS8lupPu/lK7+Hzb4+5iWmz7yfY2FzBo90k+sbu91rEC9RXDpgQkt4pa4K0dxserSCD0tR",
+	"L3q5WWqJ8k1RZgMqa1iHws6eVzl3pEGFJw08UfQcQsUnubpMRpCZE8mOW3y4YSbYXqKjCb3iar2fP3kS",
+	"0of7ElIF6+PerQ9GUC3ucfJ7/c/gxdhBdc+qDNEG61sR6VG8VR3O8YC93uMmqUDcK41rApcDUcoXRCV6",
+	"JRRfyeN/Wa3uUQQqROFtKQL1H5dvXvdVfYqaHtAo+ZpPLF8rvvQKM0j3SI/p9KjNYlQAUeeCzUl8plTM",
+	"qTyvlyuRba8DxVerwg92cqvyY83lsV+//wXr9/8FQ5bU6v/9/vi742+TxaL09F8ic5+gWFRyo9IFoyhP",
+	"TqF9m84oPp35N6K2jpSP0U3g7Hk9YNqJooD0GaQohHp2cO9gN0k5l0CNSU6PTrOZRK0uStlGQA5z39aS",
+	"vGslvBw8kQGDsmMc3itZIO6C/YiumKtCClvl4gDXS8SjVukImseo4GAinChvI6waPsV/++qC2JbPRatj",
+	"0NbAxxSpnWvrXvqFTYaBbJ47n+zj7DksDG6J6IjWkyGLqjQiHz11phR7RRHuJZVtzOtRCmVI9o0jMChV",
+	"1KnJFrKqXE5exPUiHUki2DOG6w+SWiVsRadcfE4v4LolIMq+0Dm96HsKJO1F31EQqY39Yd/T9YiftD0H",
+	"68QInlFxwp5sTdgIuGuVrCm5vxfQ7jAZi/bY4Tj63nscIHyhu3zyO/5/cJWluO1e97tl4w+RwG48oAIt",
+	"z/5ILBi30+e1Gl5IP/RIbBd9+VSJGrZ18XhDHMsP6527XehC/IgxQzt3/Q8t1QVcZjv3PKNMwhHd/QS4",
+	"alseJ7kGEm1S7PBMbBTE7XNG++6gR09ViDxwnrX7bNgfKcZ66B6fUHkw3JHua+ZtqCLWtFCGree2Jz95",
+	"F0X8GAbe8y7agTq+hCum2s9xf8anuKF4x9Bf8MxqZoLy8Lbvzl6JVXe/TA591uv4P/4NT8r7Pz7ckdzn",
+	"XfCHPY9D+KtU862p2gKMkNC0SjqF+fQCnC27J9X8UR9Zwv+Pek8bsdLGbckG5xtB5Y95WXATyz1aISiF",
+	"WVVhNLZ95duAsnai3vnipxcvzt9cXF2+q5U/JfWvFWQjr/JX1kbFf5CL7jQkY/WeFL5s6A/rWKuSPmPo",
+	"B9Up5VlMp1VBhVKNZCkJxlaTB6BLjZPOhMLq0uSNn9IYE2Yfy1ZPozWs9EM7/SxVfp8XSDXRzyHXVyDa",
+	"IVnWxJ3fcjJh+dhhbag+1K3URawUDiQRKQ1TpM65VNZh+tBgGIFuR95kVQtGrpKBQ9ZTovx6cWgwFgQQ",
+	"Hh9pa9eoN2fUqoCuQzbMXGYOHe6byTGx/TuZv/Nl2Y2Y4aC6m1D3zxXX6P9hfwpq5ot7ZBbaiuxqnPPk",
+	"d/rHFqt9zDBFrX0Z6ZJk5noIHwb4MLrMDfA+tBlZcrfs46JOh0q4tTq40TVNx3L7E0XlazFzL/18pw2Y",
+	"6cwGd6/KSEOHNo9HAi3ABoh59rnTBoyA0K3GcsdhTjBTI6wubkWNC3eQ6p7WAOp8L21xY/x7kPqniar7",
+	"fnunH7WZyjwX6tMKIhunSRdiQF52bBYMu9LU6D+hzQSFn7+b99hEXVe4HW7WuhiQHhSEEmhZ5cmuPbiq",
+	"KbO54cqlilgB9vfg9lXvD/uu3SOuSRb2KNLlye/wv2EVyMLWpfdkT8sydP0DmDWqw7GtHkdVpR7LTDq7",
+	"nRPs80gdsu7bj8Jj1QjVeFV/JChtB9TGcs7IaelExx7se6u3tmEPhnavG/0L2EXgZvRbr5ElOB7DuYLm",
+	"wWnKypS3zBWf399YuNfB8iMf+HrG/1drdfK74/NrxZdbbFNURwqXhfEp1hSGxUuu1z58yKfKuw8jopE/",
+	"dXb0+vqSd+Au5Eg9EquKHz5TqzUhZ8/mShtxLpUSeVdpgnZJgMwIqgwWqgKUVpjPqiTAthkECdYKZCcd",
+	"qPtPwxD3R//suR2E9TPuxFybNQRAxWST+56iSGmP8i4IZ26g4oyas5q7bfUMyfyqdp3G/V8fjf4f9t+l",
+	"R/wCqfapxilPfqd/XEPNq4Fer34HB/i90prt+T6hzhBw9MW/UepHaDd5gLYixJrCmwXDtseMpjamRCAS",
+	"K5BPVGaI8deqTFa3YagzaVnLFT6lUqPt2Uvw2NzYj1WioEL5yzbLVTEgW+imFsyQ3PZRB5ffwUW7gpQi",
+	"nz3fbmnWsNeVcJ8XXB3Cl3olnPiYmu7cEY3bHZoEQure/AuxKtbxMv8Ee19HYF91fADwOB/wfldp530U",
+	"W080oGC+DVMlGHKYVFlR5j5jF5mhgJnIpQh3iRGF4FawaQkZ8eH6qe4cu9AGXQCMsFXsHvX7STqsxykd",
+	"VL9ddMTv/eJR3hrC58R7d7IquFTJ8DzrjFTzTxCeFxxmQIC646ZaYMLoOBGp14T2+2hq9J0VBiDDHcqx",
+	"buf1jcCx4FxYxKUrzuwfV1fntVyVlcNOCKlk1GcqMGhzCQ+7Kj3RuxO+kifv2Iq7BSlN1TqYmi3TpcMk",
+	"FH5PIcELtYxJzaaCZfo2eEek4zsxSDBU+QxB6FCP20jAjxdsJrgrjTffrIpyLkORhNIUo6cjQBJZhF/L",
+	"dOKbol0YVSrruMqIrEvlXyZwcJnRQRnpH5q4P+1362m+lEpaZ6rJZFrN5Lz0v1jhHOawq0Bx6JOAdYE2",
+	"KkCubqrBZRfWLYSTWR0M6ecSKFWedIBArIl53HzwJ3q+tcIET65Gc/9TarDg9wXu6lV+Ct+x9mui74tb",
+	"Sjq9kdvC9238nuj9LDhQwN4B4sE0XFsh+iXR+bzhEV7vE35KdKJbKTxgZaNb9WOi4xsz50pa7kvgxlxj",
+	"ubRZSUZ4ks5gLoWcGm7WVUXJuqYjsQFqzWoZaQBs3evknDySiATq04TxEuB+1KZc1hVmYXT6JbWUdbmy",
+	"Vri1kguq3SjS6/OjLAQrVxAFTmuQ6zuFf9WJ0FqRRPklVmm/1S4cnq1LSXW9O+gfqyqig05RiIxWVc8G",
+	"QK11SCm4EjUakWMGRyAsgNqsGZqEozPJi1oJ6/q01E2qSzgpc8NXC/Y1zmRM6I+pYPk3wJfroIBNYvPO",
+	"YwuXbF5Ces4xHX7Pn5dc8bkAzl0DJ6CLRR79/gguZbzHM54txHW4Xa8Xgufeu/8ZfDkCvI0uuq5l3/6k",
+	"2fjDePTiis+3dcI2H8ajl9y6o/j829Kp2fjDhw8f/v8DACiz3QJs9gIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
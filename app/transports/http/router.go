@@ -9,6 +9,7 @@ import (
 	"github.com/Southclaws/storyden/app/transports/http/middleware/chaos"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/frontend"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/headers"
+	"github.com/Southclaws/storyden/app/transports/http/middleware/idempotency"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/limiter"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
@@ -33,6 +34,7 @@ func MountOpenAPI(
 	ri *headers.Middleware,
 	cj *session_cookie.Jar,
 	rl *limiter.Middleware,
+	im *idempotency.Middleware,
 	cm *chaos.Middleware,
 ) {
 	lc.Append(fx.StartHook(func() {
@@ -43,7 +45,8 @@ func MountOpenAPI(
 			ri.WithHeaderContext(),
 			cj.WithAuth(),
 			rl.WithRequestSizeLimiter(),
-			rl.WithRateLimit(),
+			rl.WithRateLimit(limiter.GroupAPI),
+			im.WithIdempotency(),
 			cm.WithChaos(),
 		)
 
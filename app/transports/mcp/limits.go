@@ -0,0 +1,114 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// withToolLimits abandons tool calls which run for longer than the timeout and
+// truncates results larger than maxSize bytes. Either limit is disabled if 0.
+func withToolLimits(timeout time.Duration, maxSize int) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := callWithTimeout(ctx, timeout, next, request)
+			if err != nil || result == nil {
+				return result, err
+			}
+
+			if maxSize > 0 {
+				result.Content = truncateContent(result.Content, maxSize)
+			}
+
+			return result, nil
+		}
+	}
+}
+
+type toolCallResult struct {
+	result *mcp.CallToolResult
+	err    error
+}
+
+// callWithTimeout does not wait for the tool to return once the deadline has
+// passed, so tools which do not respect context cancellation cannot hang the
+// agent. The tool's eventual result is discarded. The tool runs on its own
+// goroutine, out of reach of the server's recovery middleware, so panics are
+// recovered here and returned as an error instead of crashing the process.
+func callWithTimeout(ctx context.Context, timeout time.Duration, next server.ToolHandlerFunc, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	if timeout <= 0 {
+		return next(ctx, request)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	done := make(chan toolCallResult, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- toolCallResult{err: fmt.Errorf("panic recovered in %s tool handler: %v", request.Params.Name, r)}
+			}
+		}()
+
+		result, err := next(ctx, request)
+		done <- toolCallResult{result, err}
+	}()
+
+	select {
+	case r := <-done:
+		if errors.Is(r.err, context.DeadlineExceeded) {
+			return timeoutResult(timeout), nil
+		}
+		return r.result, r.err
+
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return timeoutResult(timeout), nil
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func timeoutResult(timeout time.Duration) *mcp.CallToolResult {
+	return mcp.NewToolResultError(fmt.Sprintf("The tool did not finish within %s and was cancelled.", timeout))
+}
+
+// truncateContent limits the combined size of the text content in a result.
+// Text beyond the limit is cut on a character boundary and marked, any content
+// after that point is dropped entirely.
+func truncateContent(content []mcp.Content, maxSize int) []mcp.Content {
+	remaining := maxSize
+
+	for i, c := range content {
+		text, ok := c.(mcp.TextContent)
+		if !ok {
+			continue
+		}
+
+		if len(text.Text) <= remaining {
+			remaining -= len(text.Text)
+			continue
+		}
+
+		cut := remaining
+		for cut > 0 && !utf8.RuneStart(text.Text[cut]) {
+			cut--
+		}
+
+		text.Text = text.Text[:cut] + fmt.Sprintf("\n\n[truncated: the result exceeded %d bytes]", maxSize)
+
+		truncated := make([]mcp.Content, i+1)
+		copy(truncated, content[:i])
+		truncated[i] = text
+
+		return truncated
+	}
+
+	return content
+}
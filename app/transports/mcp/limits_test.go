@@ -0,0 +1,183 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func textOf(t *testing.T, c mcp.Content) string {
+	text, ok := c.(mcp.TextContent)
+	require.True(t, ok, "expected text content, got %T", c)
+	return text.Text
+}
+
+func TestTruncateContent(t *testing.T) {
+	marker := func(maxSize int) string {
+		return fmt.Sprintf("\n\n[truncated: the result exceeded %d bytes]", maxSize)
+	}
+
+	t.Run("under_limit", func(t *testing.T) {
+		a := assert.New(t)
+
+		content := []mcp.Content{mcp.NewTextContent("hello")}
+		a.Equal(content, truncateContent(content, 10))
+	})
+
+	t.Run("exact_fit", func(t *testing.T) {
+		a := assert.New(t)
+
+		content := []mcp.Content{mcp.NewTextContent("hello"), mcp.NewTextContent("world")}
+		a.Equal(content, truncateContent(content, 10))
+	})
+
+	t.Run("utf8_boundary", func(t *testing.T) {
+		a := assert.New(t)
+
+		// "é" is two bytes, so a limit of 2 falls in the middle of it.
+		got := truncateContent([]mcp.Content{mcp.NewTextContent("héllo")}, 2)
+
+		a.Len(got, 1)
+		text := textOf(t, got[0])
+		a.True(utf8.ValidString(text))
+		a.Equal("h"+marker(2), text)
+	})
+
+	t.Run("multi_content_cut", func(t *testing.T) {
+		a := assert.New(t)
+
+		image := mcp.NewImageContent("aGVsbG8=", "image/png")
+		content := []mcp.Content{
+			mcp.NewTextContent("abcd"),
+			image,
+			mcp.NewTextContent("efgh"),
+			mcp.NewTextContent("ijkl"),
+		}
+
+		got := truncateContent(content, 6)
+
+		a.Len(got, 3)
+		a.Equal("abcd", textOf(t, got[0]))
+		a.Equal(image, got[1])
+		a.Equal("ef"+marker(6), textOf(t, got[2]))
+
+		// The original result is left untouched.
+		a.Len(content, 4)
+		a.Equal("efgh", textOf(t, content[2]))
+	})
+
+	t.Run("cut_at_content_boundary", func(t *testing.T) {
+		a := assert.New(t)
+
+		got := truncateContent([]mcp.Content{mcp.NewTextContent("abcd"), mcp.NewTextContent("efgh")}, 4)
+
+		a.Len(got, 2)
+		a.Equal("abcd", textOf(t, got[0]))
+		a.Equal(marker(4), textOf(t, got[1]))
+	})
+}
+
+func TestToolLimits(t *testing.T) {
+	request := mcp.CallToolRequest{}
+	request.Params.Name = "getThread"
+
+	t.Run("abandons_slow_tool", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		release := make(chan struct{})
+		defer close(release)
+
+		h := withToolLimits(10*time.Millisecond, 0)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			// Ignores cancellation entirely.
+			<-release
+			return mcp.NewToolResultText("too late"), nil
+		})
+
+		start := time.Now()
+		result, err := h(context.Background(), request)
+		r.NoError(err)
+		r.NotNil(result)
+
+		a.Less(time.Since(start), time.Second)
+		a.True(result.IsError)
+		a.Contains(textOf(t, result.Content[0]), "did not finish within 10ms")
+	})
+
+	t.Run("deadline_error_from_tool", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		h := withToolLimits(10*time.Millisecond, 0)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		result, err := h(context.Background(), request)
+		r.NoError(err)
+		r.NotNil(result)
+		a.True(result.IsError)
+	})
+
+	t.Run("caller_cancelled", func(t *testing.T) {
+		a := assert.New(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		h := withToolLimits(time.Minute, 0)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		_, err := h(ctx, request)
+		a.ErrorIs(err, context.Canceled)
+	})
+
+	t.Run("recovers_panic", func(t *testing.T) {
+		a := assert.New(t)
+
+		h := withToolLimits(time.Minute, 0)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			panic("boom")
+		})
+
+		result, err := h(context.Background(), request)
+		a.Nil(result)
+		a.ErrorContains(err, "panic recovered in getThread tool handler: boom")
+	})
+
+	t.Run("truncates_result", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		h := withToolLimits(time.Minute, 5)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("hello world"), nil
+		})
+
+		result, err := h(context.Background(), request)
+		r.NoError(err)
+		a.True(strings.HasPrefix(textOf(t, result.Content[0]), "hello\n\n[truncated"))
+	})
+
+	t.Run("limits_disabled", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		h := withToolLimits(0, 0)(func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			_, hasDeadline := ctx.Deadline()
+			a.False(hasDeadline)
+			return mcp.NewToolResultText(strings.Repeat("a", 1000)), nil
+		})
+
+		result, err := h(context.Background(), request)
+		r.NoError(err)
+		a.Len(textOf(t, result.Content[0]), 1000)
+	})
+}
@@ -3,6 +3,7 @@ package mcp
 import (
 	"go.uber.org/fx"
 
+	"github.com/Southclaws/storyden/app/transports/mcp/resources"
 	"github.com/Southclaws/storyden/app/transports/mcp/tools"
 )
 
@@ -11,6 +12,9 @@ func Build() fx.Option {
 		// Provide all the tools to the MCP server.
 		tools.Build(),
 
+		// Provide all the datagraph resources to the MCP server.
+		resources.Build(),
+
 		// Mount the MCP server into the HTTP mux.
 		fx.Invoke(MountMCP),
 	)
@@ -0,0 +1,59 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/mcp/tools"
+)
+
+// withToolPermissionFilter hides tools from the tool list which the session's
+// account does not hold the permissions for, so agents aren't offered tools
+// which would always fail.
+func withToolPermissionFilter(allTools tools.All) server.ToolFilterFunc {
+	return func(ctx context.Context, list []mcp.Tool) []mcp.Tool {
+		filtered := make([]mcp.Tool, 0, len(list))
+		for _, t := range list {
+			if toolPermitted(ctx, allTools.Permissions(t.Name)) {
+				filtered = append(filtered, t)
+			}
+		}
+
+		return filtered
+	}
+}
+
+// withToolPermissions rejects tool calls before they execute if the account
+// does not hold the permissions for the tool.
+func withToolPermissions(allTools tools.All) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			perms := allTools.Permissions(request.Params.Name)
+			if !toolPermitted(ctx, perms) {
+				return nil, fault.Wrap(rbac.ErrPermissions,
+					fctx.With(ctx),
+					fmsg.WithDesc("tool not permitted", "Your account does not have any of the permissions required to use this tool: "+rbac.PermissionList(perms).String()),
+				)
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+func toolPermitted(ctx context.Context, perms []rbac.Permission) bool {
+	if len(perms) == 0 {
+		return true
+	}
+
+	held := session.GetRoles(ctx).Permissions()
+
+	return held.HasAny(rbac.PermissionAdministrator) || held.HasAny(perms...)
+}
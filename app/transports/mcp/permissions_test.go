@@ -0,0 +1,143 @@
+package mcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/rs/xid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/resources/rbac"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/mcp/tools"
+)
+
+func withPermissions(perms ...rbac.Permission) context.Context {
+	acc := account.Account{ID: account.AccountID(xid.New())}
+	roles := role.Roles{{Name: "test", Permissions: rbac.NewList(perms...)}}
+
+	return session.WithAccount(context.Background(), acc, roles)
+}
+
+func TestToolPermitted(t *testing.T) {
+	library := []rbac.Permission{rbac.PermissionManageLibrary, rbac.PermissionSubmitLibraryNode}
+
+	t.Run("unmapped_tool_allowed", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.True(toolPermitted(withPermissions(), nil))
+	})
+
+	t.Run("administrator_bypass", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.True(toolPermitted(withPermissions(rbac.PermissionAdministrator), library))
+	})
+
+	t.Run("any_of", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.True(toolPermitted(withPermissions(rbac.PermissionManageLibrary), library))
+		a.True(toolPermitted(withPermissions(rbac.PermissionSubmitLibraryNode), library))
+		a.True(toolPermitted(withPermissions(rbac.PermissionCreatePost, rbac.PermissionSubmitLibraryNode), library))
+	})
+
+	t.Run("denied", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.False(toolPermitted(withPermissions(), library))
+		a.False(toolPermitted(withPermissions(rbac.PermissionReadPublishedLibrary), library))
+	})
+}
+
+func TestToolPermissionFilter(t *testing.T) {
+	list := []mcp.Tool{
+		mcp.NewTool("createLibraryPage"),
+		mcp.NewTool("getLibraryPage"),
+		mcp.NewTool("listThreads"),
+		mcp.NewTool("createThread"),
+		mcp.NewTool("listTags"),
+	}
+
+	names := func(ts []mcp.Tool) []string {
+		out := make([]string, 0, len(ts))
+		for _, t := range ts {
+			out = append(out, t.Name)
+		}
+		return out
+	}
+
+	filter := withToolPermissionFilter(tools.All{})
+
+	t.Run("no_permissions", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.Equal([]string{"listTags"}, names(filter(withPermissions(), list)))
+	})
+
+	t.Run("reader", func(t *testing.T) {
+		a := assert.New(t)
+
+		got := filter(withPermissions(rbac.PermissionReadPublishedLibrary, rbac.PermissionReadPublishedThreads), list)
+		a.Equal([]string{"getLibraryPage", "listThreads", "listTags"}, names(got))
+	})
+
+	t.Run("submitter", func(t *testing.T) {
+		a := assert.New(t)
+
+		got := filter(withPermissions(rbac.PermissionSubmitLibraryNode, rbac.PermissionCreatePost), list)
+		a.Equal([]string{"createLibraryPage", "createThread", "listTags"}, names(got))
+	})
+
+	t.Run("administrator", func(t *testing.T) {
+		a := assert.New(t)
+
+		a.Equal(names(list), names(filter(withPermissions(rbac.PermissionAdministrator), list)))
+	})
+}
+
+func TestToolPermissions(t *testing.T) {
+	call := func(ctx context.Context, name string) (bool, error) {
+		called := false
+		next := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			called = true
+			return mcp.NewToolResultText("ok"), nil
+		}
+
+		request := mcp.CallToolRequest{}
+		request.Params.Name = name
+
+		_, err := withToolPermissions(tools.All{})(next)(ctx, request)
+		return called, err
+	}
+
+	t.Run("permitted", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		called, err := call(withPermissions(rbac.PermissionCreatePost), "createThread")
+		r.NoError(err)
+		a.True(called)
+	})
+
+	t.Run("unmapped", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+
+		called, err := call(withPermissions(), "listTags")
+		r.NoError(err)
+		a.True(called)
+	})
+
+	t.Run("rejected_before_calling", func(t *testing.T) {
+		a := assert.New(t)
+
+		called, err := call(withPermissions(rbac.PermissionReadPublishedThreads), "createThread")
+		a.ErrorIs(err, rbac.ErrPermissions)
+		a.False(called)
+	})
+}
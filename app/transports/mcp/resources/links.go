@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/link/link_querier"
+	"github.com/Southclaws/storyden/app/resources/link/link_ref"
+)
+
+type linkResources struct {
+	resources []server.ServerResource
+	templates []server.ServerResourceTemplate
+
+	linkQuerier *link_querier.LinkQuerier
+}
+
+func newLinkResources(
+	linkQuerier *link_querier.LinkQuerier,
+) *linkResources {
+	handler := &linkResources{
+		linkQuerier: linkQuerier,
+	}
+
+	handler.resources = []server.ServerResource{
+		{Resource: linkListResource, Handler: handler.linkList},
+	}
+
+	handler.templates = []server.ServerResourceTemplate{
+		{Template: linkTemplate, Handler: handler.linkGet},
+	}
+
+	return handler
+}
+
+var linkListResource = mcp.NewResource(scheme+"links", "Links",
+	mcp.WithResourceDescription("The most recently shared links in the community bookmarks list"),
+	mcp.WithMIMEType("application/json"),
+)
+
+func (t *linkResources) linkList(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	result, err := t.linkQuerier.Search(ctx, 0, 50)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return jsonContents(request.Params.URI, dt.Map(result.Links, mapLinkRef))
+}
+
+var linkTemplate = mcp.NewResourceTemplate(scheme+"links/{slug}", "Link",
+	mcp.WithTemplateDescription("A shared link along with the library pages that reference it"),
+	mcp.WithTemplateMIMEType("application/json"),
+)
+
+func (t *linkResources) linkGet(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	slug, ok := slugArgument(request)
+	if !ok {
+		return nil, fault.New("missing link slug", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	l, err := t.linkQuerier.Get(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	result := mapLinkRef(&l.LinkRef)
+	result["library_pages"] = dt.Map(l.Nodes, func(n *library.Node) string { return scheme + "library/" + n.Mark.Slug() })
+
+	return jsonContents(request.Params.URI, result)
+}
+
+func mapLinkRef(in *link_ref.LinkRef) map[string]any {
+	return map[string]any{
+		"uri":         scheme + "links/" + in.Slug,
+		"slug":        in.Slug,
+		"url":         in.URL,
+		"domain":      in.Domain,
+		"title":       in.Title.Ptr(),
+		"description": in.Description.Ptr(),
+	}
+}
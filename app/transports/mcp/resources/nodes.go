@@ -0,0 +1,128 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/Southclaws/opt"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/resources/account/account_querier"
+	"github.com/Southclaws/storyden/app/resources/library"
+	"github.com/Southclaws/storyden/app/resources/library/node_traversal"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	"github.com/Southclaws/storyden/app/resources/visibility"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/services/library/node_read"
+)
+
+type nodeResources struct {
+	resources []server.ServerResource
+	templates []server.ServerResourceTemplate
+
+	accountQuery *account_querier.Querier
+	nodeReader   *node_read.HydratedQuerier
+	ntr          node_traversal.Repository
+}
+
+func newNodeResources(
+	accountQuery *account_querier.Querier,
+	nodeReader *node_read.HydratedQuerier,
+	ntr node_traversal.Repository,
+) *nodeResources {
+	handler := &nodeResources{
+		accountQuery: accountQuery,
+		nodeReader:   nodeReader,
+		ntr:          ntr,
+	}
+
+	handler.resources = []server.ServerResource{
+		{Resource: libraryTreeResource, Handler: handler.libraryTree},
+	}
+
+	handler.templates = []server.ServerResourceTemplate{
+		{Template: libraryPageTemplate, Handler: handler.libraryPageGet},
+	}
+
+	return handler
+}
+
+var libraryTreeResource = mcp.NewResource(scheme+"library", "Library",
+	mcp.WithResourceDescription("The full tree of pages in the library"),
+	mcp.WithMIMEType("application/json"),
+)
+
+func (t *nodeResources) libraryTree(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	accountID, err := session.GetAccountID(ctx)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	acc, err := t.accountQuery.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	tree, err := t.ntr.Subtree(ctx, opt.NewEmpty[library.NodeID](), true,
+		node_traversal.WithVisibility(opt.New(*acc), visibility.VisibilityDraft, visibility.VisibilityPublished),
+	)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return jsonContents(request.Params.URI, dt.Map(tree, mapNodeTreeItem))
+}
+
+var libraryPageTemplate = mcp.NewResourceTemplate(scheme+"library/{slug}", "Library page",
+	mcp.WithTemplateDescription("A page from the library including its content"),
+	mcp.WithTemplateMIMEType("application/json"),
+)
+
+func (t *nodeResources) libraryPageGet(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	slug, ok := slugArgument(request)
+	if !ok {
+		return nil, fault.New("missing library page slug", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	node, err := t.nodeReader.GetBySlug(ctx, library.NewKey(slug), nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return jsonContents(request.Params.URI, mapNode(node))
+}
+
+func mapNodeTreeItem(n *library.Node) map[string]any {
+	return map[string]any{
+		"uri":         scheme + "library/" + n.Mark.Slug(),
+		"slug":        n.Mark.Slug(),
+		"name":        n.Name,
+		"description": n.Description,
+		"child_pages": dt.Map(n.Nodes, mapNodeTreeItem),
+	}
+}
+
+func mapNode(n *library.Node) map[string]any {
+	result := map[string]any{
+		"uri":         scheme + "library/" + n.Mark.Slug(),
+		"slug":        n.Mark.Slug(),
+		"name":        n.Name,
+		"description": n.Description,
+		"tags":        dt.Map(n.Tags, func(t *tag_ref.Tag) string { return t.Name.String() }),
+		"child_pages": dt.Map(n.Nodes, mapNodeTreeItem),
+	}
+
+	if c, ok := n.Content.Get(); ok {
+		result["content"] = c.Plaintext()
+	}
+
+	if l, ok := n.WebLink.Get(); ok {
+		result["url"] = l.URL
+	}
+
+	return result
+}
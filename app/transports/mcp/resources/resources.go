@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"encoding/json"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"go.uber.org/fx"
+)
+
+// All resources are addressed under this scheme, for example a thread with the
+// slug "hello-world" is available at storyden://threads/hello-world.
+const scheme = "storyden://"
+
+type All struct {
+	Resources []server.ServerResource
+	Templates []server.ServerResourceTemplate
+}
+
+func newResources(
+	threadResources *threadResources,
+	nodeResources *nodeResources,
+	linkResources *linkResources,
+) All {
+	all := All{}
+
+	all.Resources = append(all.Resources, threadResources.resources...)
+	all.Resources = append(all.Resources, nodeResources.resources...)
+	all.Resources = append(all.Resources, linkResources.resources...)
+
+	all.Templates = append(all.Templates, threadResources.templates...)
+	all.Templates = append(all.Templates, nodeResources.templates...)
+	all.Templates = append(all.Templates, linkResources.templates...)
+
+	return all
+}
+
+func Build() fx.Option {
+	return fx.Options(
+		fx.Provide(
+			newThreadResources,
+			newNodeResources,
+			newLinkResources,
+			newResources,
+		),
+	)
+}
+
+func jsonContents(uri string, v any) ([]mcp.ResourceContents, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{
+			URI:      uri,
+			MIMEType: "application/json",
+			Text:     string(b),
+		},
+	}, nil
+}
+
+// slugArgument reads the {slug} variable matched from a resource template URI.
+// The server provides template variables as a list of values, a plain string
+// is also accepted for requests constructed directly.
+func slugArgument(request mcp.ReadResourceRequest) (string, bool) {
+	switch v := request.Params.Arguments["slug"].(type) {
+	case []string:
+		for _, slug := range v {
+			if slug != "" {
+				return slug, true
+			}
+		}
+	case string:
+		if v != "" {
+			return v, true
+		}
+	}
+	return "", false
+}
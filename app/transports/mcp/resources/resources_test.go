@@ -0,0 +1,71 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplateSlugArgument(t *testing.T) {
+	probe := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		slug, ok := slugArgument(request)
+		if !ok {
+			return nil, assert.AnError
+		}
+		return jsonContents(request.Params.URI, map[string]any{"slug": slug})
+	}
+
+	s := server.NewMCPServer("test", "test", server.WithResourceCapabilities(false, false))
+	s.AddResourceTemplate(threadTemplate, probe)
+	s.AddResourceTemplate(libraryPageTemplate, probe)
+	s.AddResourceTemplate(linkTemplate, probe)
+
+	for _, uri := range []string{
+		scheme + "threads/hello-world",
+		scheme + "library/hello-world",
+		scheme + "links/hello-world",
+	} {
+		t.Run(uri, func(t *testing.T) {
+			req, err := json.Marshal(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      1,
+				"method":  "resources/read",
+				"params":  map[string]any{"uri": uri},
+			})
+			require.NoError(t, err)
+
+			res, err := json.Marshal(s.HandleMessage(context.Background(), req))
+			require.NoError(t, err)
+
+			var response struct {
+				Result *struct {
+					Contents []struct {
+						URI  string `json:"uri"`
+						Text string `json:"text"`
+					} `json:"contents"`
+				} `json:"result"`
+			}
+			require.NoError(t, json.Unmarshal(res, &response))
+			require.NotNil(t, response.Result, "expected a successful response, got %s", res)
+			require.Len(t, response.Result.Contents, 1)
+
+			text := response.Result.Contents[0]
+			assert.Equal(t, uri, text.URI)
+			assert.JSONEq(t, `{"slug":"hello-world"}`, text.Text)
+		})
+	}
+
+	t.Run("direct_string", func(t *testing.T) {
+		req := mcp.ReadResourceRequest{}
+		req.Params.Arguments = map[string]any{"slug": "hello-world"}
+
+		slug, ok := slugArgument(req)
+		assert.True(t, ok)
+		assert.Equal(t, "hello-world", slug)
+	})
+}
@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/Southclaws/dt"
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/resources/pagination"
+	"github.com/Southclaws/storyden/app/resources/post/reply"
+	"github.com/Southclaws/storyden/app/resources/post/thread"
+	"github.com/Southclaws/storyden/app/resources/tag/tag_ref"
+	thread_service "github.com/Southclaws/storyden/app/services/thread"
+	"github.com/Southclaws/storyden/app/services/thread_mark"
+)
+
+type threadResources struct {
+	resources []server.ServerResource
+	templates []server.ServerResourceTemplate
+
+	thread_svc      thread_service.Service
+	thread_mark_svc thread_mark.Service
+}
+
+func newThreadResources(
+	thread_svc thread_service.Service,
+	thread_mark_svc thread_mark.Service,
+) *threadResources {
+	handler := &threadResources{
+		thread_svc:      thread_svc,
+		thread_mark_svc: thread_mark_svc,
+	}
+
+	handler.resources = []server.ServerResource{
+		{Resource: threadListResource, Handler: handler.threadList},
+	}
+
+	handler.templates = []server.ServerResourceTemplate{
+		{Template: threadTemplate, Handler: handler.threadGet},
+	}
+
+	return handler
+}
+
+var threadListResource = mcp.NewResource(scheme+"threads", "Threads",
+	mcp.WithResourceDescription("The most recent discussion threads in the forum"),
+	mcp.WithMIMEType("application/json"),
+)
+
+func (t *threadResources) threadList(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	result, err := t.thread_svc.List(ctx, 0, 50, thread_service.Params{})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return jsonContents(request.Params.URI, dt.Map(result.Threads, mapThreadSummary))
+}
+
+var threadTemplate = mcp.NewResourceTemplate(scheme+"threads/{slug}", "Thread",
+	mcp.WithTemplateDescription("A discussion thread with its first page of replies"),
+	mcp.WithTemplateMIMEType("application/json"),
+)
+
+func (t *threadResources) threadGet(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	slug, ok := slugArgument(request)
+	if !ok {
+		return nil, fault.New("missing thread slug", fctx.With(ctx), ftag.With(ftag.InvalidArgument))
+	}
+
+	postID, err := t.thread_mark_svc.Lookup(ctx, slug)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx), fmsg.With("failed to look up thread"))
+	}
+
+	thr, err := t.thread_svc.Get(ctx, postID, pagination.NewPageParams(1, 50))
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	return jsonContents(request.Params.URI, mapThread(thr))
+}
+
+func mapThreadSummary(t *thread.Thread) map[string]any {
+	return map[string]any{
+		"uri":      scheme + "threads/" + t.Slug,
+		"slug":     t.Slug,
+		"title":    t.Title,
+		"excerpt":  t.Short,
+		"author":   t.Author.Handle,
+		"category": t.Category.OrZero().Name,
+	}
+}
+
+func mapThread(t *thread.Thread) map[string]any {
+	result := map[string]any{
+		"uri":        scheme + "threads/" + t.Slug,
+		"slug":       t.Slug,
+		"created_at": t.CreatedAt,
+		"title":      t.Title,
+		"content":    t.Content.Plaintext(),
+		"author":     t.Author.Handle,
+		"category":   t.Category.OrZero().Name,
+		"tags":       dt.Map(t.Tags, func(tag *tag_ref.Tag) string { return tag.Name.String() }),
+		"replies":    dt.Map(t.Replies.Items, mapReply),
+	}
+
+	if webLink, ok := t.WebLink.Get(); ok {
+		result["url"] = webLink.URL
+	}
+
+	return result
+}
+
+func mapReply(p *reply.Reply) map[string]any {
+	return map[string]any{
+		"author":     p.Author.Handle,
+		"created_at": p.CreatedAt,
+		"content":    p.Content.Plaintext(),
+	}
+}
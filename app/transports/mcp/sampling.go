@@ -0,0 +1,84 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/internal/infrastructure/ai"
+)
+
+const samplingMaxTokens = 2048
+
+// samplingPrompter fulfils prompts by asking the connected MCP client to run
+// them against its own model. Embeddings are not part of MCP sampling so those
+// are still provided by the server's configured language model provider.
+type samplingPrompter struct {
+	ai.Prompter
+	session server.SessionWithSampling
+}
+
+func (p *samplingPrompter) Prompt(ctx context.Context, input string) (*ai.Result, error) {
+	result, err := p.session.RequestSampling(ctx, mcp.CreateMessageRequest{
+		CreateMessageParams: mcp.CreateMessageParams{
+			Messages: []mcp.SamplingMessage{
+				{Role: mcp.RoleUser, Content: mcp.NewTextContent(input)},
+			},
+			MaxTokens: samplingMaxTokens,
+		},
+	})
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	text, ok := result.Content.(mcp.TextContent)
+	if !ok {
+		return nil, fault.New("sampling result was not text content", fctx.With(ctx))
+	}
+
+	return &ai.Result{Answer: text.Text}, nil
+}
+
+func (p *samplingPrompter) PromptStream(ctx context.Context, input string) (func(yield func(string, error) bool), error) {
+	result, err := p.Prompt(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(yield func(string, error) bool) {
+		yield(result.Answer, nil)
+	}, nil
+}
+
+// withSampling routes any prompts made during a tool call through the client's
+// model when the client declared the sampling capability when it initialised.
+func withSampling(fallback ai.Prompter) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			if session, ok := samplingSession(ctx); ok {
+				ctx = ai.WithPrompter(ctx, &samplingPrompter{Prompter: fallback, session: session})
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+func samplingSession(ctx context.Context) (server.SessionWithSampling, bool) {
+	session, ok := server.ClientSessionFromContext(ctx).(server.SessionWithSampling)
+	if !ok {
+		return nil, false
+	}
+
+	// Sampling requests block until the client responds, so only clients which
+	// explicitly declared support are ever sent one.
+	info, ok := session.(server.SessionWithClientInfo)
+	if !ok || info.GetClientCapabilities().Sampling == nil {
+		return nil, false
+	}
+
+	return session, true
+}
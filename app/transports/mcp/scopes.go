@@ -0,0 +1,111 @@
+package mcp
+
+import (
+	"context"
+
+	"github.com/Southclaws/fault"
+	"github.com/Southclaws/fault/fctx"
+	"github.com/Southclaws/fault/fmsg"
+	"github.com/Southclaws/fault/ftag"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/services/authentication/session"
+	"github.com/Southclaws/storyden/app/transports/mcp/resources"
+	"github.com/Southclaws/storyden/app/transports/mcp/tools"
+)
+
+var errOutOfScope = fault.New("access key scope does not permit this operation")
+
+// withToolScopeFilter hides tools from the tool list which the access key used
+// for the session does not hold a scope for.
+func withToolScopeFilter(allTools tools.All) server.ToolFilterFunc {
+	return func(ctx context.Context, list []mcp.Tool) []mcp.Tool {
+		scopes := session.GetAccessKeyScopes(ctx)
+		if !scopes.Restricted() {
+			return list
+		}
+
+		filtered := make([]mcp.Tool, 0, len(list))
+		for _, t := range list {
+			ns, ok := allTools.Namespace(t.Name)
+			if ok && scopes.AllowsTool(ns) {
+				filtered = append(filtered, t)
+			}
+		}
+
+		return filtered
+	}
+}
+
+// withToolScopes rejects tool calls outside the access key's scopes. Filtering
+// the list is not enough as clients may call a tool by name without listing.
+func withToolScopes(allTools tools.All) server.ToolHandlerMiddleware {
+	return func(next server.ToolHandlerFunc) server.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			ns, ok := allTools.Namespace(request.Params.Name)
+			if !ok || !session.GetAccessKeyScopes(ctx).AllowsTool(ns) {
+				return nil, fault.Wrap(errOutOfScope,
+					fctx.With(ctx),
+					ftag.With(ftag.PermissionDenied),
+					fmsg.WithDesc("tool not in scope", "The access key used for this session does not have a scope for the '"+ns+"' tools."),
+				)
+			}
+
+			return next(ctx, request)
+		}
+	}
+}
+
+// withResourceScopeFilter empties the resource and resource template lists for
+// access keys which were not granted the resource read scope.
+func withResourceScopeFilter() *server.Hooks {
+	hooks := &server.Hooks{}
+
+	hooks.AddAfterListResources(func(ctx context.Context, id any, message *mcp.ListResourcesRequest, result *mcp.ListResourcesResult) {
+		if !session.GetAccessKeyScopes(ctx).AllowsResources() {
+			result.Resources = []mcp.Resource{}
+		}
+	})
+
+	hooks.AddAfterListResourceTemplates(func(ctx context.Context, id any, message *mcp.ListResourceTemplatesRequest, result *mcp.ListResourceTemplatesResult) {
+		if !session.GetAccessKeyScopes(ctx).AllowsResources() {
+			result.ResourceTemplates = []mcp.ResourceTemplate{}
+		}
+	})
+
+	return hooks
+}
+
+// withResourceScopes wraps every resource handler so that reads are rejected
+// for access keys which were not granted the resource read scope.
+func withResourceScopes(all resources.All) resources.All {
+	wrap := func(next server.ResourceHandlerFunc) server.ResourceHandlerFunc {
+		return func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+			if !session.GetAccessKeyScopes(ctx).AllowsResources() {
+				return nil, fault.Wrap(errOutOfScope,
+					fctx.With(ctx),
+					ftag.With(ftag.PermissionDenied),
+					fmsg.WithDesc("resources not in scope", "The access key used for this session does not have a scope for reading resources."),
+				)
+			}
+
+			return next(ctx, request)
+		}
+	}
+
+	scoped := resources.All{
+		Resources: make([]server.ServerResource, len(all.Resources)),
+		Templates: make([]server.ServerResourceTemplate, len(all.Templates)),
+	}
+
+	for i, r := range all.Resources {
+		scoped.Resources[i] = server.ServerResource{Resource: r.Resource, Handler: wrap(r.Handler)}
+	}
+
+	for i, t := range all.Templates {
+		scoped.Templates[i] = server.ServerResourceTemplate{Template: t.Template, Handler: wrap(t.Handler)}
+	}
+
+	return scoped
+}
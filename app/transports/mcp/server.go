@@ -4,6 +4,7 @@ import (
 	"context"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/mark3labs/mcp-go/server"
 	"go.uber.org/fx"
@@ -14,11 +15,16 @@ import (
 	"github.com/Southclaws/storyden/app/transports/http/middleware/origin"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/reqlog"
 	"github.com/Southclaws/storyden/app/transports/http/middleware/session_cookie"
+	"github.com/Southclaws/storyden/app/transports/mcp/resources"
 	"github.com/Southclaws/storyden/app/transports/mcp/tools"
 	"github.com/Southclaws/storyden/internal/config"
+	"github.com/Southclaws/storyden/internal/infrastructure/ai"
 	"github.com/Southclaws/storyden/internal/infrastructure/httpserver"
+	"github.com/Southclaws/storyden/internal/infrastructure/pubsub"
 )
 
+const streamableHeartbeatInterval = 30 * time.Second
+
 func MountMCP(
 	lc fx.Lifecycle,
 	ctx context.Context,
@@ -26,7 +32,10 @@ func MountMCP(
 	cfg config.Config,
 
 	settings *settings.SettingsRepository,
+	bus *pubsub.Bus,
+	prompter ai.Prompter,
 	allTools tools.All,
+	allResources resources.All,
 
 	mux *http.ServeMux,
 
@@ -52,28 +61,57 @@ func MountMCP(
 			set.Title.Or("Storyden"),
 			"rolling", // NOTE: Worth providing versioning yet?
 			server.WithToolCapabilities(true),
+			server.WithResourceCapabilities(false, false),
 			server.WithRecovery(),
 			server.WithLogging(),
+			server.WithHooks(withResourceScopeFilter()),
+			server.WithToolFilter(withToolScopeFilter(allTools)),
+			server.WithToolFilter(withToolPermissionFilter(allTools)),
+			server.WithToolHandlerMiddleware(withToolScopes(allTools)),
+			server.WithToolHandlerMiddleware(withToolPermissions(allTools)),
+			server.WithToolHandlerMiddleware(withToolLimits(cfg.MCPToolTimeout, cfg.MCPToolResultMaxSize)),
+			server.WithToolHandlerMiddleware(withSampling(prompter)),
 		)
 
-		s.AddTools(allTools...)
+		s.EnableSampling()
+
+		ts := newToolSettings(s, allTools, set)
+		if _, err := pubsub.Subscribe(ctx, bus, "mcp_tools_settings_update", ts.handleSettingsUpdate); err != nil {
+			return err
+		}
+
+		scopedResources := withResourceScopes(allResources)
+
+		s.AddResources(scopedResources.Resources...)
+		s.AddResourceTemplates(scopedResources.Templates...)
 
-		// MCP is mounted on the root `/mcp` path, not under `/api`.
+		apply := func(h http.Handler) http.Handler {
+			return httpserver.Apply(h,
+				co.WithCORS(),
+				lo.WithLogger(),
+				cj.WithAuth(),
+				rl.WithRequestSizeLimiter(),
+				rl.WithRateLimit(limiter.GroupMCP),
+				withStrictAuthMCP(),
+			)
+		}
+
+		// MCP is mounted on the root `/mcp` path, not under `/api`. The root
+		// path itself serves the Streamable HTTP transport.
+		streamable := server.NewStreamableHTTPServer(s,
+			server.WithEndpointPath("/mcp"),
+			server.WithHeartbeatInterval(streamableHeartbeatInterval),
+		)
+
+		// The deprecated HTTP+SSE transport is kept on sub-paths for clients
+		// which have not yet moved to Streamable HTTP.
 		sse := server.NewSSEServer(s,
 			server.WithSSEEndpoint("/mcp/sse"),
 			server.WithMessageEndpoint("/mcp/message"),
 		)
 
-		applied := httpserver.Apply(sse,
-			co.WithCORS(),
-			lo.WithLogger(),
-			cj.WithAuth(),
-			rl.WithRequestSizeLimiter(),
-			rl.WithRateLimit(),
-			withStrictAuthMCP(),
-		)
-
-		mux.Handle("/mcp/", applied)
+		mux.Handle("/mcp", apply(streamable))
+		mux.Handle("/mcp/", apply(sse))
 
 		return nil
 	}))
@@ -0,0 +1,70 @@
+package mcp
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/Southclaws/dt"
+	"github.com/mark3labs/mcp-go/server"
+
+	"github.com/Southclaws/storyden/app/resources/message"
+	"github.com/Southclaws/storyden/app/resources/settings"
+	"github.com/Southclaws/storyden/app/transports/mcp/tools"
+)
+
+// toolSettings keeps the MCP server's registered tools in sync with the tools
+// enabled in the instance settings. Replacing the tool set on the server sends
+// a notifications/tools/list_changed to every connected client so their tool
+// catalogs stay current without reconnecting.
+type toolSettings struct {
+	mu      sync.Mutex
+	s       *server.MCPServer
+	all     tools.All
+	enabled []string
+}
+
+func newToolSettings(s *server.MCPServer, all tools.All, set *settings.Settings) *toolSettings {
+	ts := &toolSettings{s: s, all: all}
+
+	enabled := ts.enabledTools(set)
+	ts.enabled = toolNames(enabled)
+
+	s.AddTools(enabled...)
+
+	return ts
+}
+
+func (ts *toolSettings) handleSettingsUpdate(ctx context.Context, event *message.EventSettingsUpdated) error {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	enabled := ts.enabledTools(event.Settings)
+	names := toolNames(enabled)
+
+	if slices.Equal(names, ts.enabled) {
+		return nil
+	}
+
+	ts.enabled = names
+	ts.s.SetTools(enabled...)
+
+	return nil
+}
+
+func (ts *toolSettings) enabledTools(set *settings.Settings) []server.ServerTool {
+	var disabled []string
+	if services, ok := set.Services.Get(); ok {
+		if mcp, ok := services.MCP.Get(); ok {
+			disabled = mcp.DisabledTools.OrZero()
+		}
+	}
+
+	return dt.Filter(ts.all.Tools, func(t server.ServerTool) bool {
+		return !slices.Contains(disabled, t.Tool.Name)
+	})
+}
+
+func toolNames(list []server.ServerTool) []string {
+	return dt.Map(list, func(t server.ServerTool) string { return t.Tool.Name })
+}
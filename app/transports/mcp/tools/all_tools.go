@@ -5,7 +5,26 @@ import (
 	"go.uber.org/fx"
 )
 
-type All []server.ServerTool
+// Tools are grouped into namespaces which access key scopes may grant, such as
+// "mcp:tools:threads" for only the thread tools.
+const (
+	NamespaceLibrary = "library"
+	NamespaceLinks   = "links"
+	NamespaceTags    = "tags"
+	NamespaceThreads = "threads"
+)
+
+type All struct {
+	Tools []server.ServerTool
+
+	namespaces map[string]string
+}
+
+// Namespace returns the namespace a tool belongs to, by tool name.
+func (a All) Namespace(name string) (string, bool) {
+	ns, ok := a.namespaces[name]
+	return ns, ok
+}
 
 func newTools(
 	nodeTools *nodeTools,
@@ -13,14 +32,24 @@ func newTools(
 	tagTools *tagTools,
 	threadTools *threadTools,
 ) All {
-	tools := []server.ServerTool{}
+	all := All{
+		Tools:      []server.ServerTool{},
+		namespaces: map[string]string{},
+	}
+
+	add := func(namespace string, tools []server.ServerTool) {
+		for _, t := range tools {
+			all.namespaces[t.Tool.Name] = namespace
+		}
+		all.Tools = append(all.Tools, tools...)
+	}
 
-	tools = append(tools, nodeTools.tools...)
-	tools = append(tools, linkTools.tools...)
-	tools = append(tools, tagTools.tools...)
-	tools = append(tools, threadTools.tools...)
+	add(NamespaceLibrary, nodeTools.tools)
+	add(NamespaceLinks, linkTools.tools)
+	add(NamespaceTags, tagTools.tools)
+	add(NamespaceThreads, threadTools.tools)
 
-	return tools
+	return all
 }
 
 func Build() fx.Option {
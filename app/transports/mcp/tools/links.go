@@ -39,6 +39,7 @@ func newLinkTools(
 
 var linkCreateTool = mcp.NewTool("createLink",
 	mcp.WithDescription("Create or update a link in the shared bookmarks list and return its OpenGraph metadata"),
+	withExternalUpsertAnnotations(),
 	mcp.WithString("url", mcp.Required()),
 )
 
@@ -53,7 +54,10 @@ func (t *linkTools) linkCreate(ctx context.Context, request mcp.CallToolRequest)
 		return nil, fault.Wrap(err, fctx.With(ctx), ftag.With(ftag.InvalidArgument))
 	}
 
-	link, wc, err := t.fetcher.ScrapeAndStore(ctx, *u)
+	// The final step is reported once the link has been stored.
+	p := newProgress(ctx, request, fetcher.ScrapeSteps)
+
+	link, wc, err := t.fetcher.ScrapeAndStore(ctx, *u, fetcher.WithProgress(p.report))
 	if err != nil {
 		return nil, fault.Wrap(err, fctx.With(ctx),
 			fmsg.WithDesc("failed to fetch link",
@@ -61,6 +65,8 @@ func (t *linkTools) linkCreate(ctx context.Context, request mcp.CallToolRequest)
 			), ftag.With(ftag.InvalidArgument))
 	}
 
+	p.report(fetcher.ScrapeSteps, "Saved "+u.String())
+
 	obj := mapLinkRef(*link, wc)
 	b, err := json.Marshal(obj)
 	if err != nil {
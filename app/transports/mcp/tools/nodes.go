@@ -85,6 +85,7 @@ func newNodeTools(
 		{Tool: libraryPageCreateTool, Handler: handler.libraryPageCreate},
 		{Tool: libraryPageUpdateTool, Handler: handler.libraryPageUpdate},
 		{Tool: libraryPageSearchTool, Handler: handler.libraryPageSearch},
+		{Tool: libraryPageSummariseTool, Handler: handler.libraryPageSummarise},
 	}
 
 	return handler
@@ -92,6 +93,7 @@ func newNodeTools(
 
 var libraryPageTreeTool = mcp.NewTool("getLibraryPageTree",
 	mcp.WithDescription("Get the full tree of pages in the library"),
+	withReadOnlyAnnotations(),
 )
 
 func (t *nodeTools) libraryPageTree(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -130,6 +132,7 @@ func (t *nodeTools) libraryPageTree(ctx context.Context, request mcp.CallToolReq
 
 var libraryPageGetTool = mcp.NewTool("getLibraryPage",
 	mcp.WithDescription("Get a specific page from the library"),
+	withReadOnlyAnnotations(),
 	mcp.WithString("slug", mcp.Required()),
 )
 
@@ -153,6 +156,45 @@ func (t *nodeTools) libraryPageGet(ctx context.Context, request mcp.CallToolRequ
 	return mcp.NewToolResultText(string(b)), nil
 }
 
+var libraryPageSummariseTool = mcp.NewTool("summariseLibraryPage",
+	mcp.WithDescription("Generate a short summary of a library page's content. If your client supports sampling, the summary is generated by your own model, otherwise the server's language model is used if one is configured."),
+	withReadOnlyAnnotations(),
+	mcp.WithString("slug", mcp.Required()),
+)
+
+func (t *nodeTools) libraryPageSummarise(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	slug, err := request.RequireString("slug")
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	// Loading the page, prompting the model and receiving its response. The
+	// model is the slow part, especially when it's the client's via sampling.
+	p := newProgress(ctx, request, 2)
+	p.report(0, "Loading "+slug)
+
+	node, err := t.nodeReader.GetBySlug(ctx, library.NewKey(slug), nil)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	content, ok := node.Content.Get()
+	if !ok {
+		return mcp.NewToolResultError("This page has no content to summarise."), nil
+	}
+
+	p.report(1, "Waiting for a summary of "+node.Name)
+
+	summary, err := t.summariser.Summarise(ctx, content)
+	if err != nil {
+		return nil, fault.Wrap(err, fctx.With(ctx))
+	}
+
+	p.report(2, "Summarised "+node.Name)
+
+	return mcp.NewToolResultText(summary), nil
+}
+
 func mapNode(n *library.Node) map[string]any {
 	return map[string]any{
 		"slug":        n.Mark.Slug(),
@@ -192,6 +234,7 @@ func mapTag(t *tag_ref.Tag) string {
 
 var libraryPageCreateTool = mcp.NewTool("createLibraryPage",
 	mcp.WithDescription("Create a new page in the library"),
+	withCreateAnnotations(),
 	mcp.WithString("name", mcp.Required(), mcp.Description("The name of the page.")),
 	mcp.WithString("slug", mcp.Description("The unique slug within Storyden for this page. If you leave this empty, a slug will be generated for you.")),
 	mcp.WithString("content", mcp.Description("The content of the page in HTML format.")),
@@ -286,6 +329,7 @@ func (t *nodeTools) libraryPageCreate(ctx context.Context, request mcp.CallToolR
 
 var libraryPageUpdateTool = mcp.NewTool("updateLibraryPage",
 	mcp.WithDescription("Update an existing page in the library"),
+	withUpdateAnnotations(),
 	mcp.WithString("slug", mcp.Required(), mcp.Description("The slug of the page to update")),
 	mcp.WithString("name", mcp.Description("The new name of the page")),
 	mcp.WithString("content", mcp.Description("The new content of the page in HTML format")),
@@ -356,6 +400,7 @@ func (t *nodeTools) libraryPageUpdate(ctx context.Context, request mcp.CallToolR
 
 var libraryPageSearchTool = mcp.NewTool("searchLibraryPages",
 	mcp.WithDescription("Search for pages in the library."),
+	withReadOnlyAnnotations(),
 	mcp.WithString("query", mcp.Required()),
 )
 
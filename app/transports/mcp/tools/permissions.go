@@ -0,0 +1,28 @@
+package tools
+
+import (
+	"github.com/Southclaws/storyden/app/resources/rbac"
+)
+
+// toolPermissions mirrors the OpenAPI RBAC mapping of each tool's equivalent
+// HTTP operation. The account must hold any one of the listed permissions. A
+// tool without an entry only requires a session, further checks such as post
+// ownership are performed by the services the tool calls.
+var toolPermissions = map[string][]rbac.Permission{
+	libraryPageTreeTool.Name:      {rbac.PermissionReadPublishedLibrary},
+	libraryPageGetTool.Name:       {rbac.PermissionReadPublishedLibrary},
+	libraryPageSummariseTool.Name: {rbac.PermissionReadPublishedLibrary},
+	libraryPageSearchTool.Name:    {rbac.PermissionReadPublishedLibrary},
+	libraryPageCreateTool.Name:    {rbac.PermissionManageLibrary, rbac.PermissionSubmitLibraryNode},
+
+	threadListTool.Name:   {rbac.PermissionReadPublishedThreads},
+	threadGetTool.Name:    {rbac.PermissionReadPublishedThreads},
+	threadCreateTool.Name: {rbac.PermissionCreatePost},
+	threadReplyTool.Name:  {rbac.PermissionCreatePost},
+}
+
+// Permissions returns the permissions, any one of which is required to call
+// the tool.
+func (a All) Permissions(name string) []rbac.Permission {
+	return toolPermissions[name]
+}
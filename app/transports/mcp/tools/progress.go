@@ -0,0 +1,48 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// progress reports the progress of a long-running tool call to the client as
+// notifications/progress messages. Clients opt in by sending a progress token
+// with the call, if there is no token then reporting does nothing.
+type progress struct {
+	ctx   context.Context
+	token mcp.ProgressToken
+	total int
+}
+
+func newProgress(ctx context.Context, request mcp.CallToolRequest, total int) *progress {
+	p := &progress{ctx: ctx, total: total}
+	if request.Params.Meta != nil {
+		p.token = request.Params.Meta.ProgressToken
+	}
+	return p
+}
+
+func (p *progress) report(step int, message string) {
+	if p.token == nil {
+		return
+	}
+
+	s := server.ServerFromContext(p.ctx)
+	if s == nil {
+		return
+	}
+
+	total := float64(p.total)
+	n := mcp.NewProgressNotification(p.token, float64(step), &total, &message)
+
+	// Progress is best-effort, a client which has gone away will not see the
+	// tool result either so there is nothing useful to do with the error.
+	_ = s.SendNotificationToClient(p.ctx, n.Method, map[string]any{
+		"progressToken": n.Params.ProgressToken,
+		"progress":      n.Params.Progress,
+		"total":         n.Params.Total,
+		"message":       n.Params.Message,
+	})
+}
@@ -36,6 +36,7 @@ func newTagTools(
 
 var tagListTool = mcp.NewTool("listTags",
 	mcp.WithDescription("Get a list of all tags on the site or search for tags by name using the optional 'query' argument."),
+	withReadOnlyAnnotations(),
 	mcp.WithString("query"),
 )
 
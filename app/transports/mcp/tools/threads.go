@@ -70,6 +70,7 @@ func newThreadTools(
 
 var threadCreateTool = mcp.NewTool("createThread",
 	mcp.WithDescription("Create a new discussion thread in the forum"),
+	withCreateAnnotations(),
 	mcp.WithString("title", mcp.Required(), mcp.Description("The title of the thread")),
 	mcp.WithString("body", mcp.Required(), mcp.Description("The content of the thread in HTML format")),
 	mcp.WithString("category", mcp.Required(), mcp.Description("The category slug for the thread")),
@@ -174,6 +175,7 @@ func (t *threadTools) threadCreate(ctx context.Context, request mcp.CallToolRequ
 
 var threadListTool = mcp.NewTool("listThreads",
 	mcp.WithDescription("List and search discussion threads"),
+	withReadOnlyAnnotations(),
 	mcp.WithString("query", mcp.Description("Search query to filter threads")),
 	mcp.WithString("visibility", mcp.Description("Filter by visibility: draft or published")),
 	mcp.WithString("page", mcp.Description("Page number (defaults to 1)")),
@@ -237,6 +239,7 @@ func (t *threadTools) threadList(ctx context.Context, request mcp.CallToolReques
 
 var threadGetTool = mcp.NewTool("getThread",
 	mcp.WithDescription("Get a specific thread with its posts and replies"),
+	withReadOnlyAnnotations(),
 	mcp.WithString("slug", mcp.Required(), mcp.Description("The thread URL slug")),
 	mcp.WithString("page", mcp.Description("Page number for replies (defaults to 1)")),
 )
@@ -278,6 +281,7 @@ func (t *threadTools) threadGet(ctx context.Context, request mcp.CallToolRequest
 
 var threadUpdateTool = mcp.NewTool("updateThread",
 	mcp.WithDescription("Update an existing thread"),
+	withUpdateAnnotations(),
 	mcp.WithString("slug", mcp.Required(), mcp.Description("The thread slug to update")),
 	mcp.WithString("title", mcp.Description("New title for the thread")),
 	mcp.WithString("body", mcp.Description("New content for the thread in HTML format")),
@@ -354,6 +358,7 @@ func (t *threadTools) threadUpdate(ctx context.Context, request mcp.CallToolRequ
 
 var threadReplyTool = mcp.NewTool("replyToThread",
 	mcp.WithDescription("Add a reply to an existing thread"),
+	withCreateAnnotations(),
 	mcp.WithString("slug", mcp.Required(), mcp.Description("The thread slug to reply to")),
 	mcp.WithString("body", mcp.Required(), mcp.Description("The reply content in HTML format")),
 )
@@ -402,6 +407,7 @@ func (t *threadTools) threadReply(ctx context.Context, request mcp.CallToolReque
 
 var listCategoresTool = mcp.NewTool("listCategories",
 	mcp.WithDescription("List all thread categories with their names and descriptions"),
+	withReadOnlyAnnotations(),
 )
 
 func (t *threadTools) listCategories(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
@@ -0,0 +1,297 @@
+// Command mcp runs a stdio MCP server which proxies to the Streamable HTTP MCP
+// endpoint of a running Storyden instance. This is for local agent clients that
+// can only launch MCP servers as subprocesses and talk to them over stdio.
+//
+// Tool calls, resource reads and notifications such as progress are forwarded
+// in both directions. Sampling requests from Storyden are forwarded to the
+// local client if it supports sampling, otherwise Storyden uses its own
+// language model provider as it would for any other client.
+//
+// Usage:
+//
+//	STORYDEN_ACCESS_KEY=sdpak_... mcp -address https://community.example.com
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"net/url"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/mark3labs/mcp-go/client"
+	"github.com/mark3labs/mcp-go/client/transport"
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+func main() {
+	addressFlag := flag.String("address", os.Getenv("STORYDEN_ADDRESS"), "public API address of the Storyden instance, defaults to $STORYDEN_ADDRESS")
+	keyFlag := flag.String("key", os.Getenv("STORYDEN_ACCESS_KEY"), "access key used to authenticate, defaults to $STORYDEN_ACCESS_KEY")
+
+	flag.Parse()
+
+	// stdout is reserved for JSON-RPC messages, everything else goes to stderr.
+	logger := log.New(os.Stderr, "storyden-mcp: ", log.LstdFlags)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, logger, *addressFlag, *keyFlag); err != nil {
+		logger.Fatal(err)
+	}
+}
+
+func run(ctx context.Context, logger *log.Logger, address, key string) error {
+	if address == "" {
+		return fmt.Errorf("no Storyden address provided, set -address or STORYDEN_ADDRESS")
+	}
+
+	if key == "" {
+		return fmt.Errorf("no access key provided, set -key or STORYDEN_ACCESS_KEY")
+	}
+
+	endpoint, err := url.JoinPath(address, "/mcp")
+	if err != nil {
+		return fmt.Errorf("invalid Storyden address: %w", err)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	p := &proxy{endpoint: endpoint, key: key, logger: logger}
+	defer p.close()
+
+	// The remote session is only opened once the local client has initialised
+	// as whether sampling can be offered to Storyden depends on the client.
+	hooks := &server.Hooks{}
+	hooks.AddAfterInitialize(func(sctx context.Context, id any, request *mcp.InitializeRequest, result *mcp.InitializeResult) {
+		info, err := p.connect(ctx, server.ClientSessionFromContext(sctx), request.Params.Capabilities)
+		if err != nil {
+			cancel(err)
+			return
+		}
+
+		result.ServerInfo = info.ServerInfo
+		result.Instructions = info.Instructions
+	})
+
+	p.local = server.NewMCPServer(
+		"Storyden",
+		"rolling",
+		server.WithToolCapabilities(true),
+		server.WithResourceCapabilities(false, false),
+		server.WithRecovery(),
+		server.WithHooks(hooks),
+	)
+
+	err = server.NewStdioServer(p.local).Listen(ctx, os.Stdin, os.Stdout)
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) {
+		return cause
+	}
+
+	return err
+}
+
+type proxy struct {
+	endpoint string
+	key      string
+	logger   *log.Logger
+	local    *server.MCPServer
+
+	mu     sync.Mutex
+	remote *client.Client
+}
+
+func (p *proxy) connect(ctx context.Context, session server.ClientSession, capabilities mcp.ClientCapabilities) (*mcp.InitializeResult, error) {
+	trans, err := transport.NewStreamableHTTP(p.endpoint,
+		transport.WithHTTPHeaders(map[string]string{
+			"Authorization": "Bearer " + p.key,
+		}),
+		// Keeps a stream open for server notifications such as tool changes.
+		transport.WithContinuousListening(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MCP client: %w", err)
+	}
+
+	// Declaring sampling without a client which can fulfil it would make
+	// Storyden wait on requests which always fail instead of falling back.
+	var opts []client.ClientOption
+	if capabilities.Sampling != nil {
+		opts = append(opts, client.WithSamplingHandler(&samplingForwarder{local: p.local, session: session}))
+	}
+
+	remote := client.NewClient(trans, opts...)
+
+	p.mu.Lock()
+	p.remote = remote
+	p.mu.Unlock()
+
+	if err := remote.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", p.endpoint, err)
+	}
+
+	info, err := remote.Initialize(ctx, mcp.InitializeRequest{
+		Params: mcp.InitializeParams{
+			ProtocolVersion: mcp.LATEST_PROTOCOL_VERSION,
+			ClientInfo: mcp.Implementation{
+				Name:    "storyden-mcp-stdio",
+				Version: "rolling",
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialise MCP session with %s: %w", p.endpoint, err)
+	}
+
+	if err := mirrorTools(ctx, remote, p.local); err != nil {
+		return nil, err
+	}
+
+	if err := mirrorResources(ctx, remote, p.local); err != nil {
+		return nil, err
+	}
+
+	remote.OnNotification(func(n mcp.JSONRPCNotification) {
+		switch n.Method {
+		case mcp.MethodNotificationToolsListChanged:
+			// Replacing the tools sends the local client its own notification.
+			if err := mirrorTools(ctx, remote, p.local); err != nil {
+				p.logger.Printf("failed to refresh tools: %v", err)
+			}
+
+		default:
+			// Anything else, such as progress for a forwarded tool call, is
+			// relayed as-is. Progress tokens are passed through unchanged
+			// with each call so the local client can match them up.
+			params := maps.Clone(n.Params.AdditionalFields)
+			if params == nil {
+				params = map[string]any{}
+			}
+			if n.Params.Meta != nil {
+				params["_meta"] = n.Params.Meta
+			}
+			p.local.SendNotificationToAllClients(n.Method, params)
+		}
+	})
+
+	p.logger.Printf("connected to %s (%s)", p.endpoint, info.ServerInfo.Name)
+
+	return info, nil
+}
+
+func (p *proxy) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.remote != nil {
+		p.remote.Close()
+	}
+}
+
+// samplingForwarder fulfils sampling requests from Storyden by sending them on
+// to the local client's session.
+type samplingForwarder struct {
+	local   *server.MCPServer
+	session server.ClientSession
+}
+
+func (f *samplingForwarder) CreateMessage(ctx context.Context, request mcp.CreateMessageRequest) (*mcp.CreateMessageResult, error) {
+	return f.local.RequestSampling(f.local.WithContext(ctx, f.session), request)
+}
+
+// mirrorTools lists the tools available on the remote server and replaces the
+// local tools with one for each which forwards calls to the remote session.
+// Replacing the tools also forwards the list change to the local client.
+func mirrorTools(ctx context.Context, remote *client.Client, s *server.MCPServer) error {
+	var tools []mcp.Tool
+
+	request := mcp.ListToolsRequest{}
+	for {
+		page, err := remote.ListToolsByPage(ctx, request)
+		if err != nil {
+			return fmt.Errorf("failed to list remote tools: %w", err)
+		}
+
+		tools = append(tools, page.Tools...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		request.Params.Cursor = page.NextCursor
+	}
+
+	forward := func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return remote.CallTool(ctx, request)
+	}
+
+	mirrored := make([]server.ServerTool, 0, len(tools))
+	for _, t := range tools {
+		mirrored = append(mirrored, server.ServerTool{Tool: t, Handler: forward})
+	}
+
+	s.SetTools(mirrored...)
+
+	return nil
+}
+
+// mirrorResources registers the remote server's resources and resource
+// templates locally, reads are forwarded to the remote session by URI.
+func mirrorResources(ctx context.Context, remote *client.Client, s *server.MCPServer) error {
+	if remote.GetServerCapabilities().Resources == nil {
+		return nil
+	}
+
+	read := func(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+		result, err := remote.ReadResource(ctx, mcp.ReadResourceRequest{
+			Params: mcp.ReadResourceParams{URI: request.Params.URI},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return result.Contents, nil
+	}
+
+	resources := mcp.ListResourcesRequest{}
+	for {
+		page, err := remote.ListResourcesByPage(ctx, resources)
+		if err != nil {
+			return fmt.Errorf("failed to list remote resources: %w", err)
+		}
+
+		for _, r := range page.Resources {
+			s.AddResource(r, read)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		resources.Params.Cursor = page.NextCursor
+	}
+
+	templates := mcp.ListResourceTemplatesRequest{}
+	for {
+		page, err := remote.ListResourceTemplatesByPage(ctx, templates)
+		if err != nil {
+			return fmt.Errorf("failed to list remote resource templates: %w", err)
+		}
+
+		for _, t := range page.ResourceTemplates {
+			s.AddResourceTemplate(t, read)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+		templates.Params.Cursor = page.NextCursor
+	}
+
+	return nil
+}
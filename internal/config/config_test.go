@@ -0,0 +1,89 @@
+package config
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func valid() Config {
+	return Config{
+		RateLimit:            1000,
+		SearchIndexChunkSize: 1000,
+		IdempotencyKeyExpiry: time.Hour,
+		AskerAbuseWindow:     time.Minute,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("zero_value_with_defaults", func(t *testing.T) {
+		c := valid()
+		c.ApplyDefaults()
+
+		require.NoError(t, c.Validate())
+	})
+
+	t.Run("unknown_enum_value", func(t *testing.T) {
+		c := valid()
+		c.ApplyDefaults()
+		c.EmailProvider = "sendgird"
+
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `EMAIL_PROVIDER must be one of "", "sendgrid", "mock", got "sendgird"`)
+	})
+
+	t.Run("required_if_string", func(t *testing.T) {
+		c := valid()
+		c.ApplyDefaults()
+		c.CacheProvider = "redis"
+
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REDIS_URL is required when CACHE_PROVIDER is \"redis\"")
+
+		c.RedisURL = url.URL{Scheme: "redis", Host: "localhost:6379"}
+		require.NoError(t, c.Validate())
+	})
+
+	t.Run("required_if_bool", func(t *testing.T) {
+		c := valid()
+		c.ApplyDefaults()
+		c.GitHubEnabled = true
+		c.GitHubClientID = "id"
+
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "OAUTH_GITHUB_CLIENT_SECRET is required")
+		assert.NotContains(t, err.Error(), "OAUTH_GITHUB_CLIENT_ID is required")
+	})
+
+	t.Run("range", func(t *testing.T) {
+		c := valid()
+		c.ApplyDefaults()
+		c.DevChaosFailRate = 1.5
+		c.RateLimit = 0
+		c.AskerAbuseWindow = 0
+		c.IdempotencyKeyExpiry = -time.Hour
+		c.MCPToolTimeout = -time.Second
+
+		err := c.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "DEV_CHAOS_FAIL_RATE must be at most 1, got 1.5")
+		assert.Contains(t, err.Error(), "RATE_LIMIT must be at least 1, got 0")
+		assert.Contains(t, err.Error(), "ASKER_ABUSE_WINDOW must be at least 1s, got 0s")
+		assert.Contains(t, err.Error(), "IDEMPOTENCY_KEY_EXPIRY must be at least 1s, got -1h0m0s")
+		assert.Contains(t, err.Error(), "MCP_TOOL_TIMEOUT must be at least 0, got -1s")
+	})
+}
+
+func TestApplyDefaults(t *testing.T) {
+	c := Config{SearchProvider: "", QueueType: "amqp"}
+	c.ApplyDefaults()
+
+	assert.Equal(t, "database", c.SearchProvider)
+	assert.Equal(t, "amqp", c.QueueType)
+}
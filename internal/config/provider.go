@@ -13,6 +13,12 @@ func Build() fx.Option {
 			return c, fault.Wrap(err, fmsg.With("failed to parse configuration from environment variables"))
 		}
 
+		c.ApplyDefaults()
+
+		if err = c.Validate(); err != nil {
+			return c, fault.Wrap(err, fmsg.With("invalid configuration"))
+		}
+
 		return
 	})
 }
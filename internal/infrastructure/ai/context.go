@@ -0,0 +1,21 @@
+package ai
+
+import "context"
+
+type prompterContextKey struct{}
+
+// WithPrompter overrides the prompter used for the remainder of a request. This
+// is used by transports which can fulfil prompts on behalf of the server, such
+// as MCP clients which support sampling.
+func WithPrompter(ctx context.Context, p Prompter) context.Context {
+	return context.WithValue(ctx, prompterContextKey{}, p)
+}
+
+// PrompterFromContext returns the prompter set by WithPrompter, if any, or the
+// fallback which is usually the server's configured language model provider.
+func PrompterFromContext(ctx context.Context, fallback Prompter) Prompter {
+	if p, ok := ctx.Value(prompterContextKey{}).(Prompter); ok {
+		return p
+	}
+	return fallback
+}
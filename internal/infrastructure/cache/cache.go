@@ -18,6 +18,10 @@ type Store interface {
 	Set(ctx context.Context, key string, object string, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
 
+	// SetNX sets the key only if it does not already exist, atomically along
+	// with its expiry, and reports whether it was set.
+	SetNX(ctx context.Context, key string, object string, ttl time.Duration) (bool, error)
+
 	HIncrBy(ctx context.Context, key string, field string, incr int64) (int, error)
 	HGetAll(ctx context.Context, key string) (map[string]string, error)
 	HDel(ctx context.Context, key string, field string) error
@@ -6,6 +6,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto/v2"
@@ -16,6 +17,11 @@ var errNotFound = fmt.Errorf("not found")
 
 type LocalCache struct {
 	cache *ristretto.Cache[string, []byte]
+
+	// hashes are read, modified and written back so concurrent increments
+	// must be serialised to behave atomically like Redis' HINCRBY, the same
+	// goes for checking and setting a key in SetNX.
+	hmu sync.Mutex
 }
 
 type HSet map[string]int
@@ -64,12 +70,28 @@ func (c *LocalCache) Set(ctx context.Context, key string, value string, ttl time
 	return nil
 }
 
+func (c *LocalCache) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	c.hmu.Lock()
+	defer c.hmu.Unlock()
+
+	if _, found := c.cache.Get(key); found {
+		return false, nil
+	}
+
+	c.cache.SetWithTTL(key, []byte(value), 0, ttl)
+	c.cache.Wait()
+	return true, nil
+}
+
 func (c *LocalCache) Delete(ctx context.Context, key string) error {
 	c.cache.Del(key)
 	return nil
 }
 
 func (c *LocalCache) HIncrBy(ctx context.Context, key string, field string, incr int64) (int, error) {
+	c.hmu.Lock()
+	defer c.hmu.Unlock()
+
 	hash, exists, err := c.getHSET(key)
 	if err != nil {
 		return 0, err
@@ -117,7 +139,10 @@ func (c *LocalCache) setHSET(key string, hset HSet) error {
 		return err
 	}
 
-	c.cache.Set(key, buf.Bytes(), 0)
+	// Writing the hash back must not clear an expiry set with Expire.
+	ttl, _ := c.cache.GetTTL(key)
+
+	c.cache.SetWithTTL(key, buf.Bytes(), 0, ttl)
 	c.cache.Wait()
 	return nil
 }
@@ -143,6 +168,9 @@ func (c *LocalCache) HGetAll(ctx context.Context, key string) (map[string]string
 }
 
 func (c *LocalCache) HDel(ctx context.Context, key string, field string) error {
+	c.hmu.Lock()
+	defer c.hmu.Unlock()
+
 	hash, exists, err := c.getHSET(key)
 	if err != nil {
 		return err
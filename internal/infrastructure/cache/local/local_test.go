@@ -2,6 +2,7 @@ package local_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -37,6 +38,56 @@ func TestLocalCache(t *testing.T) {
 		a.Equal(value, v)
 	})
 
+	t.Run("set_nx", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+		ctx := context.Background()
+
+		c, err := local.New()
+		r.NoError(err)
+
+		ok, err := c.SetNX(ctx, "key", "first", time.Minute)
+		r.NoError(err)
+		a.True(ok)
+
+		ok, err = c.SetNX(ctx, "key", "second", time.Minute)
+		r.NoError(err)
+		a.False(ok)
+
+		v, err := c.Get(ctx, "key")
+		r.NoError(err)
+		a.Equal("first", v)
+	})
+
+	t.Run("set_nx_concurrent", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+		ctx := context.Background()
+
+		c, err := local.New()
+		r.NoError(err)
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		claimed := 0
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ok, err := c.SetNX(ctx, "key", "value", time.Minute)
+				a.NoError(err)
+				if ok {
+					mu.Lock()
+					claimed++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		a.Equal(1, claimed)
+	})
+
 	t.Run("hincr", func(t *testing.T) {
 		a := assert.New(t)
 		r := require.New(t)
@@ -63,6 +114,52 @@ func TestLocalCache(t *testing.T) {
 		r.NoError(err)
 		a.Equal(map[string]string{field: "2"}, m)
 	})
+
+	t.Run("hincr_concurrent", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+		ctx := context.Background()
+
+		c, err := local.New()
+		r.NoError(err)
+
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := c.HIncrBy(ctx, "key", "f", 1)
+				a.NoError(err)
+			}()
+		}
+		wg.Wait()
+
+		m, err := c.HGetAll(ctx, "key")
+		r.NoError(err)
+		a.Equal(map[string]string{"f": "50"}, m)
+	})
+
+	t.Run("hincr_keeps_expiry", func(t *testing.T) {
+		a := assert.New(t)
+		r := require.New(t)
+		ctx := context.Background()
+
+		c, err := local.New()
+		r.NoError(err)
+
+		_, err = c.HIncrBy(ctx, "key", "f", 1)
+		r.NoError(err)
+		r.NoError(c.Expire(ctx, "key", 50*time.Millisecond))
+
+		_, err = c.HIncrBy(ctx, "key", "f", 1)
+		r.NoError(err)
+
+		time.Sleep(100 * time.Millisecond)
+
+		m, err := c.HGetAll(ctx, "key")
+		r.NoError(err)
+		a.Empty(m)
+	})
 }
 
 func BenchmarkLocalCache(b *testing.B) {
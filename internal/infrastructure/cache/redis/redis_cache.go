@@ -52,6 +52,26 @@ func (c *RedisCache) Set(ctx context.Context, key string, value string, ttl time
 	return nil
 }
 
+func (c *RedisCache) SetNX(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	cmd := c.client.B().
+		Set().
+		Key(key).
+		Value(value).
+		Nx().
+		Ex(ttl).
+		Build()
+
+	err := c.client.Do(ctx, cmd).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *RedisCache) Delete(ctx context.Context, key string) error {
 	cmd := c.client.B().
 		Del().
@@ -11,6 +11,7 @@ import (
 	"github.com/rs/xid"
 
 	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
 	"github.com/Southclaws/storyden/app/resources/account/role"
 	"github.com/Southclaws/storyden/app/services/authentication/session"
 )
@@ -19,6 +20,7 @@ const (
 	accountIDKey      = "storyden-account-id"
 	rolesKey          = "storyden-roles"
 	securitySchemeKey = "storyden-security-scheme"
+	scopesKey         = "storyden-access-key-scopes"
 )
 
 // propagates session context to message subscribers.
@@ -93,7 +95,14 @@ func (m *sessionContextMiddleware) extractSessionContext(ctx context.Context, ms
 	}
 
 	if securityScheme == "access_key" {
-		return session.WithAccessKey(ctx, acc, roles), nil
+		var scopes access_key.Scopes
+		if scopesStr := msg.Metadata.Get(scopesKey); scopesStr != "" {
+			if err := json.Unmarshal([]byte(scopesStr), &scopes); err != nil {
+				return nil, err
+			}
+		}
+
+		return session.WithAccessKey(ctx, acc, roles, scopes), nil
 	}
 
 	return session.WithAccount(ctx, acc, roles), nil
@@ -117,6 +126,12 @@ func injectSessionContext(ctx context.Context, msg *message.Message) {
 	if scheme, err := session.GetSecurityScheme(ctx); err == nil {
 		msg.Metadata.Set(securitySchemeKey, scheme)
 	}
+
+	if scopes := session.GetAccessKeyScopes(ctx); scopes.Restricted() {
+		if scopesJSON, err := json.Marshal(scopes); err == nil {
+			msg.Metadata.Set(scopesKey, string(scopesJSON))
+		}
+	}
 }
 
 func newChaosDelayMiddleware(maxDelay time.Duration, logger *slog.Logger) message.HandlerMiddleware {
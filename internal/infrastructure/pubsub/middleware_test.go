@@ -1,13 +1,20 @@
 package pubsub
 
 import (
+	"context"
 	"log/slog"
 	"testing"
 	"time"
 
 	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/Southclaws/storyden/app/resources/account"
+	"github.com/Southclaws/storyden/app/resources/account/authentication/access_key"
+	"github.com/Southclaws/storyden/app/resources/account/role"
+	"github.com/Southclaws/storyden/app/services/authentication/session"
 )
 
 func TestChaosDelayMiddleware_ZeroDelay(t *testing.T) {
@@ -88,3 +95,34 @@ func TestChaosDelayMiddleware_MultipleCalls(t *testing.T) {
 
 	assert.LessOrEqual(t, elapsed, 2*maxDelay+20*time.Millisecond)
 }
+
+func TestSessionContextMiddleware_AccessKeyScopes(t *testing.T) {
+	m := &sessionContextMiddleware{logger: slog.Default()}
+	acc := account.Account{ID: account.AccountID(xid.New())}
+
+	t.Run("scoped", func(t *testing.T) {
+		scopes := access_key.Scopes{access_key.ToolScope("threads"), access_key.ScopeResourcesRead}
+		ctx := session.WithAccessKey(context.Background(), acc, role.Roles{}, scopes)
+
+		msg := message.NewMessage("test-uuid", nil)
+		injectSessionContext(ctx, msg)
+
+		got, err := m.extractSessionContext(context.Background(), msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, scopes, session.GetAccessKeyScopes(got))
+		assert.False(t, session.GetAccessKeyScopes(got).AllowsTool("library"))
+	})
+
+	t.Run("unscoped", func(t *testing.T) {
+		ctx := session.WithAccessKey(context.Background(), acc, role.Roles{}, nil)
+
+		msg := message.NewMessage("test-uuid", nil)
+		injectSessionContext(ctx, msg)
+
+		got, err := m.extractSessionContext(context.Background(), msg)
+		require.NoError(t, err)
+
+		assert.False(t, session.GetAccessKeyScopes(got).Restricted())
+	})
+}
@@ -34,12 +34,30 @@ type Section struct {
 }
 
 type ConfigOption struct {
-	Env         string  `yaml:"env"`
-	Name        string  `yaml:"name"`
-	Description string  `yaml:"description"`
-	Type        string  `yaml:"type"`
-	Required    bool    `yaml:"required"`
-	Default     *string `yaml:"default"`
+	Env         string     `yaml:"env"`
+	Name        string     `yaml:"name"`
+	Description string     `yaml:"description"`
+	Type        string     `yaml:"type"`
+	Required    bool       `yaml:"required"`
+	RequiredIf  *Condition `yaml:"required_if"`
+	Default     *string    `yaml:"default"`
+	Enum        []string   `yaml:"enum"`
+	Min         *string    `yaml:"min"`
+	Max         *string    `yaml:"max"`
+}
+
+// Condition refers to another configuration option by its environment variable
+// name and the value it must be set to for the condition to be satisfied.
+type Condition struct {
+	Env   string `yaml:"env"`
+	Value string `yaml:"value"`
+}
+
+func (f ConfigOption) FieldName() string {
+	if f.Required {
+		return f.Name + "Required"
+	}
+	return f.Name
 }
 
 func run(filename, outputPkgFile, outputDocFile string) error {
@@ -88,10 +106,7 @@ The term "Settings" is distinct from these and refers to runtime-configurable va
 			for _, f := range section.Fields {
 				// Generate golang code
 
-				fieldName := f.Name
-				if f.Required {
-					fieldName = fieldName + "Required"
-				}
+				fieldName := f.FieldName()
 
 				tags := map[string]string{
 					"envconfig": f.Env,
@@ -121,9 +136,9 @@ The term "Settings" is distinct from these and refers to runtime-configurable va
 				properties := fmt.Sprintf(`<table>
 <tr><td>type</td><td>%s</td></tr>
 <tr><td>default</td><td>%s</td></tr>
-</table>
+%s</table>
 
-`, getNonTechnicalTypeName(f.Type), getPrettyDefault(f.Default))
+`, getNonTechnicalTypeName(f.Type), getPrettyDefault(f.Default), getConstraintRows(f))
 
 				// Generate markdown documentation
 				markdown.WriteString(fmt.Sprintf("### `%s`\n\n", f.Env))
@@ -137,6 +152,12 @@ The term "Settings" is distinct from these and refers to runtime-configurable va
 		}
 	})
 
+	generateApplyDefaults(f, sections)
+
+	if err := generateValidate(f, sections); err != nil {
+		return err
+	}
+
 	// Generate the markdown documentation
 	docFile, err := os.Create(outputDocFile)
 	if err != nil {
@@ -218,6 +239,44 @@ func getNonTechnicalTypeName(s string) string {
 	}
 }
 
+func getConstraintRows(f ConfigOption) string {
+	rows := strings.Builder{}
+
+	if f.Required {
+		rows.WriteString("<tr><td>required</td><td>yes</td></tr>\n")
+	}
+
+	if f.RequiredIf != nil {
+		rows.WriteString(fmt.Sprintf("<tr><td>required</td><td>when `%s` is `%s`</td></tr>\n", f.RequiredIf.Env, f.RequiredIf.Value))
+	}
+
+	if len(f.Enum) > 0 {
+		rows.WriteString(fmt.Sprintf("<tr><td>values</td><td>%s</td></tr>\n", getPrettyEnum(f.Enum)))
+	}
+
+	if f.Min != nil {
+		rows.WriteString(fmt.Sprintf("<tr><td>minimum</td><td>`%s`</td></tr>\n", *f.Min))
+	}
+
+	if f.Max != nil {
+		rows.WriteString(fmt.Sprintf("<tr><td>maximum</td><td>`%s`</td></tr>\n", *f.Max))
+	}
+
+	return rows.String()
+}
+
+func getPrettyEnum(values []string) string {
+	pretty := make([]string, len(values))
+	for i, v := range values {
+		if v == "" {
+			pretty[i] = "(empty string)"
+		} else {
+			pretty[i] = fmt.Sprintf("`%s`", v)
+		}
+	}
+	return strings.Join(pretty, ", ")
+}
+
 func getPrettyDefault(s *string) string {
 	if s == nil {
 		return "none"
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// generateApplyDefaults emits a method which fills in string options that were
+// explicitly set to an empty value. envconfig only applies defaults when a var
+// is entirely absent, so `SEARCH_PROVIDER=` would otherwise bypass the default.
+func generateApplyDefaults(f *jen.File, sections []Section) {
+	f.Comment("ApplyDefaults sets any string options which were explicitly set to an empty")
+	f.Comment("value to their declared default value.")
+	f.Func().Params(jen.Id("c").Op("*").Id("Config")).Id("ApplyDefaults").Params().BlockFunc(func(g *jen.Group) {
+		for _, section := range sections {
+			for _, o := range section.Fields {
+				if o.Type != "string" || o.Default == nil || *o.Default == "" {
+					continue
+				}
+
+				field := jen.Id("c").Dot(o.FieldName())
+
+				g.If(field.Clone().Op("==").Lit("")).Block(
+					field.Clone().Op("=").Lit(*o.Default),
+				)
+			}
+		}
+	})
+}
+
+// generateValidate emits a Validate method that checks every constraint
+// declared in the schema and reports all failures at once.
+func generateValidate(f *jen.File, sections []Section) error {
+	fields := map[string]ConfigOption{}
+	for _, section := range sections {
+		for _, o := range section.Fields {
+			fields[o.Env] = o
+		}
+	}
+
+	var genErr error
+
+	f.Comment("Validate checks the configuration against the constraints declared in the")
+	f.Comment("config.yaml schema such as allowed values, ranges and conditional requirements.")
+	f.Func().Params(jen.Id("c").Id("Config")).Id("Validate").Params().Error().BlockFunc(func(g *jen.Group) {
+		g.Var().Id("errs").Index().Error()
+
+		for _, section := range sections {
+			for _, o := range section.Fields {
+				if err := validateOption(g, fields, o); err != nil {
+					genErr = fmt.Errorf("%s: %w", o.Env, err)
+					return
+				}
+			}
+		}
+
+		g.Return(jen.Qual("errors", "Join").Call(jen.Id("errs").Op("...")))
+	})
+
+	return genErr
+}
+
+func validateOption(g *jen.Group, fields map[string]ConfigOption, o ConfigOption) error {
+	field := jen.Id("c").Dot(o.FieldName())
+
+	appendErr := func(err jen.Code) jen.Code {
+		return jen.Id("errs").Op("=").Append(jen.Id("errs"), err)
+	}
+
+	if o.Required {
+		zero, err := zeroCheck(field.Clone(), o.Type)
+		if err != nil {
+			return err
+		}
+
+		g.If(zero).Block(
+			appendErr(jen.Qual("errors", "New").Call(jen.Lit(fmt.Sprintf("%s is required", o.Env)))),
+		)
+	}
+
+	if c := o.RequiredIf; c != nil {
+		target, ok := fields[c.Env]
+		if !ok {
+			return fmt.Errorf("required_if refers to unknown option %s", c.Env)
+		}
+
+		cond, err := equalityCheck(jen.Id("c").Dot(target.FieldName()), target.Type, c.Value)
+		if err != nil {
+			return err
+		}
+
+		zero, err := zeroCheck(field.Clone(), o.Type)
+		if err != nil {
+			return err
+		}
+
+		g.If(cond.Op("&&").Add(zero)).Block(
+			appendErr(jen.Qual("errors", "New").Call(jen.Lit(fmt.Sprintf("%s is required when %s is %q", o.Env, c.Env, c.Value)))),
+		)
+	}
+
+	if len(o.Enum) > 0 {
+		if o.Type != "string" {
+			return fmt.Errorf("enum is only supported for string options, got %s", o.Type)
+		}
+
+		g.If(jen.Op("!").Qual("slices", "Contains").Call(
+			jen.Index().String().ValuesFunc(func(g *jen.Group) {
+				for _, v := range o.Enum {
+					g.Lit(v)
+				}
+			}),
+			field.Clone(),
+		)).Block(
+			appendErr(jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("%s must be one of %s, got %%q", o.Env, strings.Join(quoteAll(o.Enum), ", "))),
+				field.Clone(),
+			)),
+		)
+	}
+
+	if o.Min != nil {
+		lit, err := rangeLiteral(o.Type, *o.Min)
+		if err != nil {
+			return err
+		}
+
+		g.If(field.Clone().Op("<").Add(lit)).Block(
+			appendErr(jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("%s must be at least %s, got %%v", o.Env, *o.Min)),
+				field.Clone(),
+			)),
+		)
+	}
+
+	if o.Max != nil {
+		lit, err := rangeLiteral(o.Type, *o.Max)
+		if err != nil {
+			return err
+		}
+
+		g.If(field.Clone().Op(">").Add(lit)).Block(
+			appendErr(jen.Qual("fmt", "Errorf").Call(
+				jen.Lit(fmt.Sprintf("%s must be at most %s, got %%v", o.Env, *o.Max)),
+				field.Clone(),
+			)),
+		)
+	}
+
+	return nil
+}
+
+func zeroCheck(field *jen.Statement, typ string) (*jen.Statement, error) {
+	switch typ {
+	case "string":
+		return field.Op("==").Lit(""), nil
+
+	case "[]byte":
+		return jen.Len(field).Op("==").Lit(0), nil
+
+	case "net/url.URL":
+		return field.Dot("String").Call().Op("==").Lit(""), nil
+
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64",
+		"float32", "float64", "time.Duration":
+		return field.Op("==").Lit(0), nil
+
+	default:
+		return nil, fmt.Errorf("cannot check %s for presence", typ)
+	}
+}
+
+func equalityCheck(field *jen.Statement, typ string, value string) (*jen.Statement, error) {
+	switch typ {
+	case "string":
+		return field.Op("==").Lit(value), nil
+
+	case "bool":
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			return field, nil
+		}
+		return jen.Op("!").Add(field), nil
+
+	default:
+		return nil, fmt.Errorf("required_if is only supported for string and bool options, got %s", typ)
+	}
+}
+
+func rangeLiteral(typ string, value string) (jen.Code, error) {
+	switch typ {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return jen.Lit(int(n)), nil
+
+	case "float32", "float64":
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, err
+		}
+		return jen.Lit(n), nil
+
+	case "time.Duration":
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, err
+		}
+		return jen.Qual("time", "Duration").Call(jen.Lit(int(d))), nil
+
+	default:
+		return nil, fmt.Errorf("range constraints are not supported for %s", typ)
+	}
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return quoted
+}
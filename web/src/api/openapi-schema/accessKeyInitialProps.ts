@@ -7,10 +7,12 @@ The Storyden API does not adhere to semantic versioning but instead applies a ro
 
  * OpenAPI spec version: v1.26.2-canary
  */
+import type { AccessKeyScopeList } from "./accessKeyScopeList";
 
 export interface AccessKeyInitialProps {
   /** When the access key expires, if null, it never expires. */
   expires_at?: string;
   /** The name of the access key. */
   name: string;
+  scopes?: AccessKeyScopeList;
 }
@@ -7,6 +7,7 @@ The Storyden API does not adhere to semantic versioning but instead applies a ro
 
  * OpenAPI spec version: v1.26.2-canary
  */
+import type { AccessKeyScopeList } from "./accessKeyScopeList";
 
 export interface AccessKeyProps {
   enabled: boolean;
@@ -14,4 +15,5 @@ export interface AccessKeyProps {
   expires_at?: string;
   /** The name of the access key. */
   name: string;
+  scopes?: AccessKeyScopeList;
 }
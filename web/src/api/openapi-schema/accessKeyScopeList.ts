@@ -0,0 +1,21 @@
+/**
+ * Generated by orval v7.2.0 🍺
+ * Do not edit manually.
+ * storyden
+ * Storyden social API for building community driven platforms.
+The Storyden API does not adhere to semantic versioning but instead applies a rolling strategy with deprecations and minimal breaking changes. This has been done mainly for a simpler development process and it may be changed to a more fixed versioning strategy in the future. Ultimately, the primary way Storyden tracks versions is dates, there are no set release tags currently.
+
+ * OpenAPI spec version: v1.26.2-canary
+ */
+import type { AccessKeyScope } from "./accessKeyScope";
+
+/**
+ * Restricts the access key to the MCP server. Tool calls are limited to
+the namespaces granted by "mcp:tools:<namespace>" scopes, such as
+"mcp:tools:threads" or "mcp:tools:*" for every namespace, and reading
+resources requires the "mcp:resources:read" scope. A key with scopes
+cannot be used with the rest of the API. If omitted or empty, the key
+is not restricted.
+
+ */
+export type AccessKeyScopeList = AccessKeyScope[];
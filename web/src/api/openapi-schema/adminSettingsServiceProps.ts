@@ -7,8 +7,10 @@ The Storyden API does not adhere to semantic versioning but instead applies a ro
 
  * OpenAPI spec version: v1.26.2-canary
  */
+import type { MCPServiceSettings } from "./mCPServiceSettings";
 import type { ModerationServiceSettings } from "./moderationServiceSettings";
 
 export interface AdminSettingsServiceProps {
+  mcp?: MCPServiceSettings;
   moderation?: ModerationServiceSettings;
 }
@@ -0,0 +1,26 @@
+/**
+ * Generated by orval v7.2.0 🍺
+ * Do not edit manually.
+ * storyden
+ * Storyden social API for building community driven platforms.
+The Storyden API does not adhere to semantic versioning but instead applies a rolling strategy with deprecations and minimal breaking changes. This has been done mainly for a simpler development process and it may be changed to a more fixed versioning strategy in the future. Ultimately, the primary way Storyden tracks versions is dates, there are no set release tags currently.
+
+ * OpenAPI spec version: v1.26.2-canary
+ */
+import type { AskerAbuseAction } from "./askerAbuseAction";
+import type { AskerAbuseRule } from "./askerAbuseRule";
+import type { AuditEventAskerAbuseDetectedType } from "./auditEventAskerAbuseDetectedType";
+
+/**
+ * A question sent to the Asker triggered an abuse detection rule. This is
+only recorded the first time a rule is triggered by the same member or
+guest IP address within the configured abuse detection window.
+
+ */
+export interface AuditEventAskerAbuseDetected {
+  action: AskerAbuseAction;
+  /** The IP address of the client, only present for guests. */
+  client_address?: string;
+  rule: AskerAbuseRule;
+  type: AuditEventAskerAbuseDetectedType;
+}
@@ -18,4 +18,5 @@ export const AuditEventType = {
   account_suspended: "account_suspended",
   account_unsuspended: "account_unsuspended",
   account_content_purged: "account_content_purged",
+  asker_abuse_detected: "asker_abuse_detected",
 } as const;
@@ -10,6 +10,7 @@ The Storyden API does not adhere to semantic versioning but instead applies a ro
 import type { AuditEventAccountContentPurged } from "./auditEventAccountContentPurged";
 import type { AuditEventAccountSuspended } from "./auditEventAccountSuspended";
 import type { AuditEventAccountUnsuspended } from "./auditEventAccountUnsuspended";
+import type { AuditEventAskerAbuseDetected } from "./auditEventAskerAbuseDetected";
 import type { AuditEventThreadDeleted } from "./auditEventThreadDeleted";
 import type { AuditEventThreadReplyDeleted } from "./auditEventThreadReplyDeleted";
 
@@ -18,4 +19,5 @@ export type AuditEventTypeProps =
   | AuditEventThreadReplyDeleted
   | AuditEventAccountSuspended
   | AuditEventAccountUnsuspended
-  | AuditEventAccountContentPurged;
+  | AuditEventAccountContentPurged
+  | AuditEventAskerAbuseDetected;
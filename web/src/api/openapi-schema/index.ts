@@ -19,6 +19,8 @@ export * from "./accessKeyList";
 export * from "./accessKeyListOKResponse";
 export * from "./accessKeyListResult";
 export * from "./accessKeyProps";
+export * from "./accessKeyScope";
+export * from "./accessKeyScopeList";
 export * from "./accessKeySecret";
 export * from "./account";
 export * from "./accountAuthMethod";
@@ -53,6 +55,8 @@ export * from "./adminSettingsProps";
 export * from "./adminSettingsServiceProps";
 export * from "./adminSettingsUpdateBody";
 export * from "./adminSettingsUpdateOKResponse";
+export * from "./askerAbuseAction";
+export * from "./askerAbuseRule";
 export * from "./asset";
 export * from "./assetGetOKResponse";
 export * from "./assetID";
@@ -72,6 +76,8 @@ export * from "./auditEventAccountSuspended";
 export * from "./auditEventAccountSuspendedType";
 export * from "./auditEventAccountUnsuspended";
 export * from "./auditEventAccountUnsuspendedType";
+export * from "./auditEventAskerAbuseDetected";
+export * from "./auditEventAskerAbuseDetectedType";
 export * from "./auditEventCreatedOKResponse";
 export * from "./auditEventGetOKResponse";
 export * from "./auditEventList";
@@ -291,6 +297,7 @@ export * from "./linkReferenceList";
 export * from "./linkReferenceProps";
 export * from "./linkSlug";
 export * from "./linkTitle";
+export * from "./mCPServiceSettings";
 export * from "./mark";
 export * from "./memberJoinedDate";
 export * from "./memberSuspendedDate";
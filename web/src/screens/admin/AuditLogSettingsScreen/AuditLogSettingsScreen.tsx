@@ -266,6 +266,29 @@ function EventDetails({ event }: { event: AuditEvent }) {
         </LStack>
       );
 
+    case AuditEventType.asker_abuse_detected:
+      return (
+        <HStack gap="1" flexWrap="wrap">
+          <styled.p fontSize="sm" color="fg.subtle">
+            Rule:
+          </styled.p>
+          <Badge size="sm" variant="subtle">
+            {event.rule}
+          </Badge>
+          <styled.p fontSize="sm" color="fg.subtle">
+            Action:
+          </styled.p>
+          <Badge size="sm" variant="subtle">
+            {event.action}
+          </Badge>
+          {event.client_address && (
+            <styled.p fontSize="sm" color="fg.subtle">
+              Client: <code>{event.client_address}</code>
+            </styled.p>
+          )}
+        </HStack>
+      );
+
     default:
       return null;
   }
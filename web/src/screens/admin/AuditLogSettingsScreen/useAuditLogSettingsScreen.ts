@@ -18,6 +18,7 @@ export const EVENT_TYPE_LABELS: Record<AuditEventType, string> = {
   [AuditEventType.account_suspended]: "Account Suspended",
   [AuditEventType.account_unsuspended]: "Account Unsuspended",
   [AuditEventType.account_content_purged]: "Content Purged",
+  [AuditEventType.asker_abuse_detected]: "Asker Abuse Detected",
 };
 
 export const ALL_EVENT_TYPES: MultiSelectPickerItem[] = Object.entries(